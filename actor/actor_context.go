@@ -31,6 +31,8 @@ type actorContextExtras struct {
 	watchers            PIDSet
 	context             Context
 	extensions          *ctxext.ContextExtensions
+	backoffDeadLetter   bool
+	pinnedDispatcher    *pinnedActorDispatcher
 }
 
 func newActorContextExtras(context Context) *actorContextExtras {
@@ -473,6 +475,16 @@ func (ctx *actorContext) InvokeUserMessage(md interface{}) {
 		return
 	}
 
+	if ctx.extras != nil && ctx.extras.backoffDeadLetter {
+		switch UnwrapEnvelopeMessage(md).(type) {
+		case AutoReceiveMessage, SystemMessage:
+		default:
+			// waiting for a delayed restart, see BackoffDeadLetterMessages
+			ctx.actorSystem.DeadLetter.SendUserMessage(ctx.self, md)
+			return
+		}
+	}
+
 	influenceTimeout := true
 	if ctx.receiveTimeout > 0 {
 		_, influenceTimeout = md.(NotInfluenceReceiveTimeout)
@@ -563,6 +575,8 @@ func (ctx *actorContext) InvokeSystemMessage(message interface{}) {
 		ctx.handleFailure(msg)
 	case *Restart:
 		ctx.handleRestart()
+	case *backoffDeadLetter:
+		ctx.ensureExtras().backoffDeadLetter = true
 	default:
 		ctx.Logger().Error("unknown system message", slog.Any("message", msg))
 	}
@@ -591,6 +605,10 @@ func (ctx *actorContext) handleUnwatch(msg *Unwatch) {
 }
 
 func (ctx *actorContext) handleRestart() {
+	if ctx.extras != nil {
+		ctx.extras.backoffDeadLetter = false
+	}
+
 	atomic.StoreInt32(&ctx.state, stateRestarting)
 	ctx.InvokeUserMessage(restartingMessage)
 	ctx.stopAllChildren()
@@ -681,6 +699,7 @@ func (ctx *actorContext) restart() {
 
 func (ctx *actorContext) finalizeStop() {
 	ctx.actorSystem.ProcessRegistry.Remove(ctx.self)
+	ctx.actorSystem.shutdown.untrackUserActor(ctx.self)
 	ctx.InvokeUserMessage(stoppedMessage)
 
 	otherStopped := &Terminated{Who: ctx.self}
@@ -696,6 +715,10 @@ func (ctx *actorContext) finalizeStop() {
 	}
 
 	atomic.StoreInt32(&ctx.state, stateStopped)
+
+	if ctx.extras != nil && ctx.extras.pinnedDispatcher != nil {
+		ctx.extras.pinnedDispatcher.stop()
+	}
 }
 
 //
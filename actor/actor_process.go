@@ -29,3 +29,8 @@ func (ref *ActorProcess) Stop(pid *PID) {
 	atomic.StoreInt32(&ref.dead, 1)
 	ref.SendSystemMessage(pid, stopMessage)
 }
+
+// UserMessageCount returns the number of user messages waiting in the mailbox of the actor
+func (ref *ActorProcess) UserMessageCount() int {
+	return ref.mailbox.UserMessageCount()
+}
@@ -21,6 +21,7 @@ type ActorSystem struct {
 	Config          *Config
 	ID              string
 	stopper         chan struct{}
+	shutdown        *CoordinatedShutdown
 	logger          *slog.Logger
 }
 
@@ -53,8 +54,33 @@ func (as *ActorSystem) GetHostPort() (host string, port int, err error) {
 	return
 }
 
-func (as *ActorSystem) Shutdown() {
-	close(as.stopper)
+// DeadLetterStore returns the store keeping the last dead letters, nil unless enabled with WithDeadLetterStore
+func (as *ActorSystem) DeadLetterStore() *DeadLetterStore {
+	return as.DeadLetter.store
+}
+
+// CoordinatedShutdown returns the coordinator extensions use to register shutdown tasks
+func (as *ActorSystem) CoordinatedShutdown() *CoordinatedShutdown {
+	return as.shutdown
+}
+
+// Shutdown runs the coordinated shutdown phases and stops the actor system.
+// The returned error summarizes the shutdown tasks that failed or did not finish in time.
+// Shutdown waits for the user actors to stop, an actor must use ShutdownAsync instead as
+// it cannot stop while it is blocked in its own Receive.
+func (as *ActorSystem) Shutdown() error {
+	return as.shutdown.Run()
+}
+
+// ShutdownAsync starts the coordinated shutdown without waiting for it to complete.
+// The returned channel receives the result of Shutdown once the actor system is stopped.
+func (as *ActorSystem) ShutdownAsync() <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- as.shutdown.Run()
+	}()
+
+	return done
 }
 
 func (as *ActorSystem) IsStopped() bool {
@@ -88,6 +114,7 @@ func NewActorSystemWithConfig(config *Config) *ActorSystem {
 
 	system.ProcessRegistry.Add(NewEventStreamProcess(system), "eventstream")
 	system.stopper = make(chan struct{})
+	system.shutdown = newCoordinatedShutdown(system)
 
 	system.Logger().Info("actor system started", slog.String("id", system.ID))
 
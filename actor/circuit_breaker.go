@@ -0,0 +1,523 @@
+package actor
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int32
+
+const (
+	// CircuitClosed lets all calls through and tracks their outcome
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails all calls fast until the reset timeout elapsed
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of trial calls through, their outcome closes or opens the circuit again
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "Closed"
+	case CircuitOpen:
+		return "Open"
+	case CircuitHalfOpen:
+		return "HalfOpen"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int32(s))
+	}
+}
+
+// CircuitOpenError is the error of a call rejected by an open CircuitBreaker. Guarded requests fail with it,
+// e.g. the error of a Future returned by RequestFuture.
+type CircuitOpenError struct {
+	Name       string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker: %s is open, retry after %v", e.Name, e.RetryAfter)
+}
+
+func (e *CircuitOpenError) futureError() {}
+
+// CircuitBreakerStateChanged is published on the EventStream when a CircuitBreaker changes state
+type CircuitBreakerStateChanged struct {
+	Name string
+	From CircuitState
+	To   CircuitState
+}
+
+// CircuitBreakerOption configures a CircuitBreaker
+type CircuitBreakerOption func(config *circuitBreakerConfig)
+
+// WithCircuitFailureRate opens the circuit once the given fraction of the calls in the window failed, defaults to 0.5
+func WithCircuitFailureRate(rate float64) CircuitBreakerOption {
+	return func(config *circuitBreakerConfig) {
+		config.failureRate = rate
+	}
+}
+
+// WithCircuitWindow sets the number of most recent calls the failure rate is computed over and the number of calls
+// needed before the circuit can open, defaults to 20 and 10
+func WithCircuitWindow(size int, minimumCalls int) CircuitBreakerOption {
+	return func(config *circuitBreakerConfig) {
+		config.windowSize = size
+		config.minimumCalls = minimumCalls
+	}
+}
+
+// WithCircuitCallTimeout counts a call taking longer than the timeout as a failure, defaults to 5 seconds
+func WithCircuitCallTimeout(timeout time.Duration) CircuitBreakerOption {
+	return func(config *circuitBreakerConfig) {
+		config.callTimeout = timeout
+	}
+}
+
+// WithCircuitResetTimeout sets how long the circuit stays open before trial calls are let through, defaults to
+// 10 seconds
+func WithCircuitResetTimeout(timeout time.Duration) CircuitBreakerOption {
+	return func(config *circuitBreakerConfig) {
+		config.resetTimeout = timeout
+	}
+}
+
+// WithCircuitHalfOpenCalls sets the number of trial calls let through while half-open, defaults to 1
+func WithCircuitHalfOpenCalls(calls int) CircuitBreakerOption {
+	return func(config *circuitBreakerConfig) {
+		config.halfOpenCalls = calls
+	}
+}
+
+// WithCircuitFailurePredicate decides which responses count as failures, by default a *DeadLetterResponse or
+// a response that is an error
+func WithCircuitFailurePredicate(predicate func(response interface{}) bool) CircuitBreakerOption {
+	return func(config *circuitBreakerConfig) {
+		config.isFailure = predicate
+	}
+}
+
+type circuitBreakerConfig struct {
+	failureRate   float64
+	windowSize    int
+	minimumCalls  int
+	callTimeout   time.Duration
+	resetTimeout  time.Duration
+	halfOpenCalls int
+	isFailure     func(response interface{}) bool
+}
+
+func newCircuitBreakerConfig(opts []CircuitBreakerOption) circuitBreakerConfig {
+	config := circuitBreakerConfig{
+		failureRate:   0.5,
+		windowSize:    20,
+		minimumCalls:  10,
+		callTimeout:   5 * time.Second,
+		resetTimeout:  10 * time.Second,
+		halfOpenCalls: 1,
+		isFailure:     isFailureResponse,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+func isFailureResponse(response interface{}) bool {
+	switch response.(type) {
+	case *DeadLetterResponse, error:
+		return true
+	default:
+		return false
+	}
+}
+
+// CircuitBreaker fails calls fast once too many of the recent ones failed, giving the callee time to recover
+type CircuitBreaker struct {
+	name        string
+	actorSystem *ActorSystem
+	config      circuitBreakerConfig
+
+	mu       sync.Mutex
+	state    CircuitState
+	outcomes []bool // ring buffer of the outcomes of the recent calls, true for a failure
+	next     int
+	failures int
+	openedAt time.Time
+	trials   int
+	inFlight int       // allowed calls not recorded yet
+	lastCall time.Time // when the last call was allowed
+	// transitions are published once the lock is released, subscribers may use the circuit breaker
+	transitions []*CircuitBreakerStateChanged
+}
+
+// NewCircuitBreaker creates a closed circuit breaker, its state transitions are published on the EventStream of
+// the actor system
+func NewCircuitBreaker(actorSystem *ActorSystem, name string, opts ...CircuitBreakerOption) *CircuitBreaker {
+	return newCircuitBreaker(actorSystem, name, newCircuitBreakerConfig(opts))
+}
+
+func newCircuitBreaker(actorSystem *ActorSystem, name string, config circuitBreakerConfig) *CircuitBreaker {
+	return &CircuitBreaker{
+		name:        name,
+		actorSystem: actorSystem,
+		config:      config,
+		outcomes:    make([]bool, 0, config.windowSize),
+	}
+}
+
+// Name returns the name of the circuit breaker
+func (cb *CircuitBreaker) Name() string {
+	return cb.name
+}
+
+// State returns the current state of the circuit breaker
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	cb.expireOpen()
+
+	return cb.state
+}
+
+// Allow reserves a call, it returns a *CircuitOpenError if the call must fail fast. Every allowed call must be
+// followed by Success or Failure.
+func (cb *CircuitBreaker) Allow() error {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	cb.expireOpen()
+
+	switch cb.state {
+	case CircuitOpen:
+		return &CircuitOpenError{Name: cb.name, RetryAfter: cb.config.resetTimeout - time.Since(cb.openedAt)}
+	case CircuitHalfOpen:
+		if cb.trials >= cb.config.halfOpenCalls {
+			return &CircuitOpenError{Name: cb.name}
+		}
+		cb.trials++
+	}
+
+	cb.inFlight++
+	cb.lastCall = time.Now()
+
+	return nil
+}
+
+// Success records an allowed call that succeeded
+func (cb *CircuitBreaker) Success() {
+	cb.record(false)
+}
+
+// Failure records an allowed call that failed
+func (cb *CircuitBreaker) Failure() {
+	cb.record(true)
+}
+
+// Call runs f if the circuit allows it. The call fails if f returns an error or takes longer than the call timeout,
+// f is not interrupted though.
+func (cb *CircuitBreaker) Call(f func() error) error {
+	if err := cb.Allow(); err != nil {
+		return err
+	}
+
+	started := time.Now()
+	err := f()
+
+	if err != nil || time.Since(started) > cb.config.callTimeout {
+		cb.Failure()
+	} else {
+		cb.Success()
+	}
+
+	return err
+}
+
+// RequestFuture sends the message to pid if the circuit allows it, the returned future fails with a
+// *CircuitOpenError otherwise. The call fails if the future fails or resolves to a failure response.
+func (cb *CircuitBreaker) RequestFuture(context SenderContext, pid *PID, message interface{}, timeout time.Duration) *Future {
+	if err := cb.Allow(); err != nil {
+		future := NewFuture(cb.actorSystem, -1)
+		future.PID().sendUserMessage(cb.actorSystem, err)
+
+		return future
+	}
+
+	future := context.RequestFuture(pid, message, timeout)
+	future.continueWith(func(res interface{}, err error) {
+		if err != nil || cb.config.isFailure(res) {
+			cb.Failure()
+		} else {
+			cb.Success()
+		}
+	})
+
+	return future
+}
+
+func (cb *CircuitBreaker) record(failure bool) {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	if cb.inFlight > 0 {
+		cb.inFlight--
+	}
+
+	switch cb.state {
+	case CircuitHalfOpen:
+		if failure {
+			cb.transition(CircuitOpen)
+		} else {
+			cb.transition(CircuitClosed)
+		}
+	case CircuitClosed:
+		cb.observe(failure)
+		calls := len(cb.outcomes)
+		if calls >= cb.config.minimumCalls && float64(cb.failures)/float64(calls) >= cb.config.failureRate {
+			cb.transition(CircuitOpen)
+		}
+	}
+}
+
+// observe adds the outcome to the window, replacing the oldest one once it is full
+func (cb *CircuitBreaker) observe(failure bool) {
+	if len(cb.outcomes) < cb.config.windowSize {
+		cb.outcomes = append(cb.outcomes, failure)
+	} else {
+		if cb.outcomes[cb.next] {
+			cb.failures--
+		}
+		cb.outcomes[cb.next] = failure
+		cb.next = (cb.next + 1) % cb.config.windowSize
+	}
+
+	if failure {
+		cb.failures++
+	}
+}
+
+// expireOpen moves an open circuit to half-open once the reset timeout elapsed
+func (cb *CircuitBreaker) expireOpen() {
+	if cb.state == CircuitOpen && time.Since(cb.openedAt) >= cb.config.resetTimeout {
+		cb.transition(CircuitHalfOpen)
+	}
+}
+
+func (cb *CircuitBreaker) transition(to CircuitState) {
+	from := cb.state
+	if from == to {
+		return
+	}
+
+	cb.state = to
+	cb.trials = 0
+
+	switch to {
+	case CircuitOpen:
+		cb.openedAt = time.Now()
+	case CircuitClosed:
+		cb.outcomes = cb.outcomes[:0]
+		cb.next = 0
+		cb.failures = 0
+	}
+
+	cb.transitions = append(cb.transitions, &CircuitBreakerStateChanged{Name: cb.name, From: from, To: to})
+}
+
+// idle reports whether the circuit is closed and no call was allowed or is in flight for the given duration
+func (cb *CircuitBreaker) idle(duration time.Duration) bool {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	return cb.state == CircuitClosed && cb.inFlight == 0 && time.Since(cb.lastCall) >= duration
+}
+
+func (cb *CircuitBreaker) unlock() {
+	transitions := cb.transitions
+	cb.transitions = nil
+	cb.mu.Unlock()
+
+	for _, transition := range transitions {
+		cb.actorSystem.EventStream.Publish(transition)
+	}
+}
+
+// NewCircuitBreakerMiddleware creates a SenderMiddleware guarding the requests to every target PID with its own
+// CircuitBreaker named after the PID. Requests to a target whose circuit is open are not sent, the sender
+// receives a *CircuitOpenError instead. Messages sent without a sender are not guarded.
+//
+// A request without a response within the call timeout counts as a failure, its response is still forwarded to the
+// sender once it arrives. The circuit breakers of targets which were not called for the reset timeout are dropped
+// while closed.
+func NewCircuitBreakerMiddleware(opts ...CircuitBreakerOption) SenderMiddleware {
+	breakers := newCircuitBreakers(newCircuitBreakerConfig(opts))
+
+	return func(next SenderFunc) SenderFunc {
+		return func(c SenderContext, target *PID, envelope *MessageEnvelope) {
+			if envelope.Sender == nil {
+				next(c, target, envelope)
+				return
+			}
+
+			actorSystem := c.ActorSystem()
+			cb := breakers.get(actorSystem, target)
+
+			if err := cb.Allow(); err != nil {
+				envelope.Sender.sendUserMessage(actorSystem, err)
+				return
+			}
+
+			observed := *envelope
+			observed.Sender = newCircuitObserver(actorSystem, cb, envelope.Sender)
+			next(c, target, &observed)
+		}
+	}
+}
+
+// circuitBreakers holds the circuit breakers of the targets of a middleware
+type circuitBreakers struct {
+	config   circuitBreakerConfig
+	mu       sync.Mutex
+	breakers map[string]*CircuitBreaker
+	swept    time.Time
+}
+
+func newCircuitBreakers(config circuitBreakerConfig) *circuitBreakers {
+	return &circuitBreakers{
+		config:   config,
+		breakers: make(map[string]*CircuitBreaker),
+		swept:    time.Now(),
+	}
+}
+
+// get returns the circuit breaker of the target, creating it on first use
+func (b *circuitBreakers) get(actorSystem *ActorSystem, target *PID) *CircuitBreaker {
+	name := target.Address + "/" + target.Id
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// the targets come and go, a closed circuit breaker without calls holds no state worth keeping
+	if time.Since(b.swept) >= b.config.resetTimeout {
+		b.swept = time.Now()
+
+		for key, cb := range b.breakers {
+			if cb.idle(b.config.resetTimeout) {
+				delete(b.breakers, key)
+			}
+		}
+	}
+
+	cb, ok := b.breakers[name]
+	if !ok {
+		cb = newCircuitBreaker(actorSystem, name, b.config)
+		b.breakers[name] = cb
+	}
+
+	return cb
+}
+
+// circuitObserver is registered as the sender of a guarded request, it records the outcome of the request
+// and forwards the response to the original sender. A request timing out is recorded as a failure, the observer
+// stays registered to forward the late response unless the local sender is gone.
+type circuitObserver struct {
+	actorSystem *ActorSystem
+	breaker     *CircuitBreaker
+	target      *PID
+	mu          sync.Mutex // guards the timer, which is assigned after it may have fired
+	timer       *time.Timer
+	done        int32
+	recorded    int32
+}
+
+var _ Process = &circuitObserver{}
+
+func newCircuitObserver(actorSystem *ActorSystem, breaker *CircuitBreaker, target *PID) *PID {
+	ref := &circuitObserver{
+		actorSystem: actorSystem,
+		breaker:     breaker,
+		target:      target,
+	}
+
+	pid, _ := actorSystem.ProcessRegistry.Add(ref, "circuit"+actorSystem.ProcessRegistry.NextId())
+
+	ref.mu.Lock()
+	ref.timer = time.AfterFunc(breaker.config.callTimeout, func() { ref.expire(pid) })
+	ref.mu.Unlock()
+
+	return pid
+}
+
+// expire records the request as failed, the observer is kept until the response arrives or the sender is gone
+func (ref *circuitObserver) expire(pid *PID) {
+	ref.record(true)
+
+	if _, ok := ref.actorSystem.ProcessRegistry.Get(ref.target); !ok {
+		ref.complete(pid)
+
+		return
+	}
+
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	if atomic.LoadInt32(&ref.done) == 0 {
+		ref.timer.Reset(ref.breaker.config.callTimeout)
+	}
+}
+
+// record reports the outcome of the request to the circuit breaker once
+func (ref *circuitObserver) record(failure bool) {
+	if !atomic.CompareAndSwapInt32(&ref.recorded, 0, 1) {
+		return
+	}
+
+	if failure {
+		ref.breaker.Failure()
+	} else {
+		ref.breaker.Success()
+	}
+}
+
+func (ref *circuitObserver) complete(pid *PID) bool {
+	if !atomic.CompareAndSwapInt32(&ref.done, 0, 1) {
+		return false
+	}
+
+	ref.mu.Lock()
+	ref.timer.Stop()
+	ref.mu.Unlock()
+
+	ref.actorSystem.ProcessRegistry.Remove(pid)
+
+	return true
+}
+
+func (ref *circuitObserver) SendUserMessage(pid *PID, message interface{}) {
+	if !ref.complete(pid) {
+		return
+	}
+
+	ref.record(ref.breaker.config.isFailure(UnwrapEnvelopeMessage(message)))
+	ref.target.sendUserMessage(ref.actorSystem, message)
+}
+
+func (ref *circuitObserver) SendSystemMessage(pid *PID, message interface{}) {
+	if ref.complete(pid) {
+		ref.record(false)
+		ref.target.sendSystemMessage(ref.actorSystem, message)
+	}
+}
+
+func (ref *circuitObserver) Stop(pid *PID) {
+	if ref.complete(pid) {
+		ref.record(true)
+	}
+}
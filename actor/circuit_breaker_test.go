@@ -0,0 +1,209 @@
+package actor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func subscribeCircuitTransitions(system *ActorSystem) func() []CircuitState {
+	var (
+		mu     sync.Mutex
+		states []CircuitState
+	)
+
+	system.EventStream.Subscribe(func(evt interface{}) {
+		if changed, ok := evt.(*CircuitBreakerStateChanged); ok {
+			mu.Lock()
+			states = append(states, changed.To)
+			mu.Unlock()
+		}
+	})
+
+	return func() []CircuitState {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]CircuitState(nil), states...)
+	}
+}
+
+func TestCircuitBreaker_OpensOnFailureRateAndRecovers(t *testing.T) {
+	system := NewActorSystem()
+	transitions := subscribeCircuitTransitions(system)
+
+	cb := NewCircuitBreaker(system, "test", WithCircuitWindow(4, 4), WithCircuitFailureRate(0.5), WithCircuitResetTimeout(50*time.Millisecond))
+	failed := errors.New("failed")
+
+	assert.NoError(t, cb.Call(func() error { return nil }))
+	assert.NoError(t, cb.Call(func() error { return nil }))
+	assert.ErrorIs(t, cb.Call(func() error { return failed }), failed)
+	assert.Equal(t, CircuitClosed, cb.State())
+	assert.ErrorIs(t, cb.Call(func() error { return failed }), failed)
+	assert.Equal(t, CircuitOpen, cb.State())
+
+	var openErr *CircuitOpenError
+	require.ErrorAs(t, cb.Call(func() error { return nil }), &openErr)
+	assert.Equal(t, "test", openErr.Name)
+
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, CircuitHalfOpen, cb.State())
+
+	// a single trial call is let through while half-open
+	require.NoError(t, cb.Allow())
+	assert.ErrorAs(t, cb.Allow(), &openErr)
+	cb.Success()
+
+	assert.Equal(t, CircuitClosed, cb.State())
+	assert.Equal(t, []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitClosed}, transitions())
+}
+
+func TestCircuitBreaker_FailedTrialReopens(t *testing.T) {
+	system := NewActorSystem()
+
+	cb := NewCircuitBreaker(system, "test", WithCircuitWindow(1, 1), WithCircuitResetTimeout(20*time.Millisecond))
+	cb.Failure()
+	assert.Equal(t, CircuitOpen, cb.State())
+
+	time.Sleep(30 * time.Millisecond)
+	require.NoError(t, cb.Allow())
+	cb.Failure()
+
+	assert.Equal(t, CircuitOpen, cb.State())
+}
+
+func TestCircuitBreaker_CallTimeoutCountsAsFailure(t *testing.T) {
+	system := NewActorSystem()
+
+	cb := NewCircuitBreaker(system, "test", WithCircuitWindow(1, 1), WithCircuitCallTimeout(time.Millisecond))
+	assert.NoError(t, cb.Call(func() error {
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	}))
+
+	assert.Equal(t, CircuitOpen, cb.State())
+}
+
+func TestCircuitBreaker_RequestFuture(t *testing.T) {
+	system := NewActorSystem()
+
+	pid := system.Root.Spawn(PropsFromFunc(func(ctx Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			ctx.Respond(errors.New(msg))
+		}
+	}))
+
+	cb := NewCircuitBreaker(system, "test", WithCircuitWindow(2, 2))
+	for i := 0; i < 2; i++ {
+		_, err := cb.RequestFuture(system.Root, pid, "failed", testTimeout).Result()
+		require.NoError(t, err)
+	}
+
+	assert.Eventually(t, func() bool { return cb.State() == CircuitOpen }, testTimeout, time.Millisecond)
+
+	_, err := cb.RequestFuture(system.Root, pid, "failed", testTimeout).Result()
+	var openErr *CircuitOpenError
+	assert.ErrorAs(t, err, &openErr)
+}
+
+func TestCircuitBreakerMiddleware_FailsRequestsFastWhileOpen(t *testing.T) {
+	system := NewActorSystem()
+	transitions := subscribeCircuitTransitions(system)
+
+	root := NewRootContext(system, nil, NewCircuitBreakerMiddleware(
+		WithCircuitWindow(2, 2),
+		WithCircuitCallTimeout(20*time.Millisecond),
+		WithCircuitResetTimeout(time.Hour),
+	))
+
+	// never responds, every request times out
+	pid := system.Root.Spawn(PropsFromFunc(func(ctx Context) {}))
+
+	for i := 0; i < 2; i++ {
+		root.RequestFuture(pid, "hello", time.Hour)
+	}
+
+	assert.Eventually(t, func() bool {
+		return len(transitions()) == 1
+	}, testTimeout, time.Millisecond)
+
+	started := time.Now()
+	_, err := root.RequestFuture(pid, "hello", time.Hour).Result()
+	var openErr *CircuitOpenError
+	require.ErrorAs(t, err, &openErr)
+	assert.Equal(t, pid.Address+"/"+pid.Id, openErr.Name)
+	assert.Less(t, time.Since(started), time.Second)
+}
+
+func TestCircuitBreakerMiddleware_ForwardsResponses(t *testing.T) {
+	system := NewActorSystem()
+
+	root := NewRootContext(system, nil, NewCircuitBreakerMiddleware())
+	pid := system.Root.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			ctx.Respond("world")
+		}
+	}))
+
+	res, err := root.RequestFuture(pid, "hello", testTimeout).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "world", res)
+}
+
+func TestCircuitBreakerMiddleware_ForwardsLateResponses(t *testing.T) {
+	system := NewActorSystem()
+	transitions := subscribeCircuitTransitions(system)
+
+	root := NewRootContext(system, nil, NewCircuitBreakerMiddleware(
+		WithCircuitWindow(1, 1),
+		WithCircuitCallTimeout(20*time.Millisecond),
+		WithCircuitResetTimeout(time.Hour),
+	))
+	pid := system.Root.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			time.Sleep(100 * time.Millisecond)
+			ctx.Respond("world")
+		}
+	}))
+
+	res, err := root.RequestFuture(pid, "hello", testTimeout).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "world", res)
+
+	// the request timed out nonetheless and opened the circuit
+	assert.Len(t, transitions(), 1)
+}
+
+func TestCircuitBreakerMiddleware_EvictsIdleBreakers(t *testing.T) {
+	system := NewActorSystem()
+	breakers := newCircuitBreakers(newCircuitBreakerConfig([]CircuitBreakerOption{
+		WithCircuitWindow(1, 1),
+		WithCircuitResetTimeout(20 * time.Millisecond),
+	}))
+
+	closed := breakers.get(system, NewPID(localAddress, "closed"))
+	require.NoError(t, closed.Allow())
+	closed.Success()
+
+	open := breakers.get(system, NewPID(localAddress, "open"))
+	require.NoError(t, open.Allow())
+	open.Failure()
+
+	busy := breakers.get(system, NewPID(localAddress, "busy"))
+	require.NoError(t, busy.Allow())
+
+	time.Sleep(30 * time.Millisecond)
+	breakers.get(system, NewPID(localAddress, "other"))
+
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+
+	assert.NotContains(t, breakers.breakers, localAddress+"/closed")
+	assert.Contains(t, breakers.breakers, localAddress+"/open")
+	assert.Contains(t, breakers.breakers, localAddress+"/busy")
+	assert.Contains(t, breakers.breakers, localAddress+"/other")
+}
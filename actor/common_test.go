@@ -216,3 +216,29 @@ func (m *mockProcess) SendSystemMessage(pid *PID, message interface{}) {
 func (m *mockProcess) Stop(pid *PID) {
 	m.Called(pid)
 }
+
+// mockSupervisor
+type mockSupervisor struct {
+	mock.Mock
+}
+
+func (m *mockSupervisor) Children() []*PID {
+	args := m.Called()
+	return args.Get(0).([]*PID)
+}
+
+func (m *mockSupervisor) EscalateFailure(reason interface{}, message interface{}) {
+	m.Called(reason, message)
+}
+
+func (m *mockSupervisor) RestartChildren(pids ...*PID) {
+	m.Called(pids)
+}
+
+func (m *mockSupervisor) StopChildren(pids ...*PID) {
+	m.Called(pids)
+}
+
+func (m *mockSupervisor) ResumeChildren(pids ...*PID) {
+	m.Called(pids)
+}
@@ -20,10 +20,12 @@ type Config struct {
 	DeadLetterThrottleInterval  time.Duration      // throttle deadletter logging after this interval
 	DeadLetterThrottleCount     int32              // throttle deadletter logging after this count
 	DeadLetterRequestLogging    bool               // do not log dead-letters with sender
+	DeadLetterStoreSize         int                // keep the last dead letters in a DeadLetterStore when > 0
 	DeveloperSupervisionLogging bool               // console log and promote supervision logs to Warning level
 	DiagnosticsSerializer       func(Actor) string // extract diagnostics from actor and return as string
 	MetricsProvider             metric.MeterProvider
 	LoggerFactory               func(system *ActorSystem) *slog.Logger
+	ShutdownTimeout             time.Duration // default timeout of a coordinated shutdown task
 }
 
 func defaultConfig() *Config {
@@ -33,6 +35,7 @@ func defaultConfig() *Config {
 		DeadLetterThrottleCount:     3,
 		DeadLetterRequestLogging:    true,
 		DeveloperSupervisionLogging: false,
+		ShutdownTimeout:             10 * time.Second,
 		DiagnosticsSerializer: func(actor Actor) string {
 			return ""
 		},
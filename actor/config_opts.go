@@ -41,6 +41,14 @@ func WithDeadLetterRequestLogging(enabled bool) ConfigOption {
 	}
 }
 
+// WithDeadLetterStore keeps the last size dead letters so that they can be inspected and replayed, see
+// ActorSystem.DeadLetterStore
+func WithDeadLetterStore(size int) ConfigOption {
+	return func(config *Config) {
+		config.DeadLetterStoreSize = size
+	}
+}
+
 // WithDeveloperSupervisionLogging sets the developer supervision logging on or off
 func WithDeveloperSupervisionLogging(enabled bool) ConfigOption {
 	return func(config *Config) {
@@ -79,3 +87,10 @@ func WithLoggerFactory(factory func(system *ActorSystem) *slog.Logger) ConfigOpt
 		config.LoggerFactory = factory
 	}
 }
+
+// WithShutdownTimeout sets the default timeout of coordinated shutdown tasks
+func WithShutdownTimeout(timeout time.Duration) ConfigOption {
+	return func(config *Config) {
+		config.ShutdownTimeout = timeout
+	}
+}
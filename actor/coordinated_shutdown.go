@@ -0,0 +1,227 @@
+package actor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ErrShutdownTaskTimeout is the error used when a shutdown task does not complete within its timeout.
+var ErrShutdownTaskTimeout = errors.New("shutdown: task timeout")
+
+// ShutdownPhase identifies a step of the coordinated shutdown of an ActorSystem.
+// Phases run in ascending order, tasks within the same phase run concurrently.
+type ShutdownPhase int32
+
+const (
+	// ShutdownPhaseStopAccepting stops accepting new work, e.g. inbound remote connections
+	ShutdownPhaseStopAccepting ShutdownPhase = iota
+	// ShutdownPhaseLeaveCluster gracefully leaves the cluster and hands over activations
+	ShutdownPhaseLeaveCluster
+	// ShutdownPhaseDrainPubSub lets pending pubsub deliveries complete
+	ShutdownPhaseDrainPubSub
+	// ShutdownPhaseStopUserActors poisons all top-level actors and waits for them to stop
+	ShutdownPhaseStopUserActors
+	// ShutdownPhaseStopRemote stops the remote transport
+	ShutdownPhaseStopRemote
+	// ShutdownPhaseFlushPersistence flushes persistence providers
+	ShutdownPhaseFlushPersistence
+)
+
+var shutdownPhases = []ShutdownPhase{
+	ShutdownPhaseStopAccepting,
+	ShutdownPhaseLeaveCluster,
+	ShutdownPhaseDrainPubSub,
+	ShutdownPhaseStopUserActors,
+	ShutdownPhaseStopRemote,
+	ShutdownPhaseFlushPersistence,
+}
+
+func (p ShutdownPhase) String() string {
+	switch p {
+	case ShutdownPhaseStopAccepting:
+		return "StopAccepting"
+	case ShutdownPhaseLeaveCluster:
+		return "LeaveCluster"
+	case ShutdownPhaseDrainPubSub:
+		return "DrainPubSub"
+	case ShutdownPhaseStopUserActors:
+		return "StopUserActors"
+	case ShutdownPhaseStopRemote:
+		return "StopRemote"
+	case ShutdownPhaseFlushPersistence:
+		return "FlushPersistence"
+	default:
+		return fmt.Sprintf("ShutdownPhase(%d)", int32(p))
+	}
+}
+
+// ShutdownTask is a unit of work executed during coordinated shutdown.
+// The context is canceled when the task timeout elapses.
+type ShutdownTask func(ctx context.Context) error
+
+type shutdownTask struct {
+	name    string
+	timeout time.Duration
+	task    ShutdownTask
+}
+
+// CoordinatedShutdown runs the registered shutdown tasks of an ActorSystem phase by phase.
+type CoordinatedShutdown struct {
+	actorSystem *ActorSystem
+	mu          sync.Mutex
+	tasks       map[ShutdownPhase][]shutdownTask
+	userActors  sync.Map
+	once        sync.Once
+	done        chan struct{}
+	err         error
+}
+
+func newCoordinatedShutdown(actorSystem *ActorSystem) *CoordinatedShutdown {
+	cs := &CoordinatedShutdown{
+		actorSystem: actorSystem,
+		tasks:       make(map[ShutdownPhase][]shutdownTask),
+		done:        make(chan struct{}),
+	}
+
+	cs.AddTask(ShutdownPhaseStopUserActors, "stop-user-actors", actorSystem.Config.ShutdownTimeout, cs.stopUserActors)
+
+	return cs
+}
+
+// AddTask registers a task to run in the given phase, a timeout <= 0 uses Config.ShutdownTimeout.
+// Tasks added after shutdown has started are ignored.
+func (cs *CoordinatedShutdown) AddTask(phase ShutdownPhase, name string, timeout time.Duration, task ShutdownTask) {
+	if timeout <= 0 {
+		timeout = cs.actorSystem.Config.ShutdownTimeout
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.tasks[phase] = append(cs.tasks[phase], shutdownTask{
+		name:    name,
+		timeout: timeout,
+		task:    task,
+	})
+}
+
+// Run executes all phases once, subsequent calls wait for the first run and return its result.
+// The returned error joins the errors of every task that failed or did not finish in time.
+func (cs *CoordinatedShutdown) Run() error {
+	cs.once.Do(func() {
+		go func() {
+			cs.err = cs.run()
+			close(cs.done)
+		}()
+	})
+	<-cs.done
+
+	return cs.err
+}
+
+func (cs *CoordinatedShutdown) run() error {
+	logger := cs.actorSystem.Logger()
+
+	cs.mu.Lock()
+	tasks := cs.tasks
+	cs.tasks = make(map[ShutdownPhase][]shutdownTask)
+	cs.mu.Unlock()
+
+	var errs []error
+
+	for _, phase := range shutdownPhases {
+		phaseTasks := tasks[phase]
+		if len(phaseTasks) == 0 {
+			continue
+		}
+
+		logger.Debug("running shutdown phase", slog.String("phase", phase.String()), slog.Int("tasks", len(phaseTasks)))
+
+		results := make(chan error, len(phaseTasks))
+		for _, t := range phaseTasks {
+			go func(t shutdownTask) {
+				results <- cs.runTask(phase, t)
+			}(t)
+		}
+
+		for range phaseTasks {
+			if err := <-results; err != nil {
+				logger.Warn("shutdown task failed", slog.String("phase", phase.String()), slog.Any("error", err))
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	close(cs.actorSystem.stopper)
+
+	return errors.Join(errs...)
+}
+
+func (cs *CoordinatedShutdown) runTask(phase ShutdownPhase, t shutdownTask) error {
+	ctx, cancel := context.WithTimeout(context.Background(), t.timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- t.task(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("shutdown task %q in phase %s: %w", t.name, phase, err)
+		}
+
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown task %q in phase %s: %w", t.name, phase, ErrShutdownTaskTimeout)
+	}
+}
+
+func (cs *CoordinatedShutdown) trackUserActor(pid *PID) {
+	cs.userActors.Store(pid.Id, pid)
+}
+
+func (cs *CoordinatedShutdown) untrackUserActor(pid *PID) {
+	cs.userActors.Delete(pid.Id)
+}
+
+// stopUserActors poisons every actor spawned from a root context and waits for them to terminate
+func (cs *CoordinatedShutdown) stopUserActors(ctx context.Context) error {
+	var futures []*Future
+
+	cs.userActors.Range(func(_, value interface{}) bool {
+		futures = append(futures, cs.actorSystem.Root.PoisonFuture(value.(*PID)))
+
+		return true
+	})
+
+	stopped := make(chan error, len(futures))
+	for _, f := range futures {
+		f.continueWith(func(_ interface{}, err error) {
+			stopped <- err
+		})
+	}
+
+	for range futures {
+		select {
+		case err := <-stopped:
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package actor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoordinatedShutdown_RunsPhasesInOrder(t *testing.T) {
+	system := NewActorSystem()
+
+	var (
+		mu    sync.Mutex
+		order []ShutdownPhase
+	)
+	record := func(phase ShutdownPhase) ShutdownTask {
+		return func(_ context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, phase)
+			return nil
+		}
+	}
+
+	cs := system.CoordinatedShutdown()
+	cs.AddTask(ShutdownPhaseFlushPersistence, "flush", 0, record(ShutdownPhaseFlushPersistence))
+	cs.AddTask(ShutdownPhaseStopRemote, "remote", 0, record(ShutdownPhaseStopRemote))
+	cs.AddTask(ShutdownPhaseStopAccepting, "accept", 0, record(ShutdownPhaseStopAccepting))
+	cs.AddTask(ShutdownPhaseLeaveCluster, "leave", 0, record(ShutdownPhaseLeaveCluster))
+
+	assert.NoError(t, system.Shutdown())
+	assert.True(t, system.IsStopped())
+	assert.Equal(t, []ShutdownPhase{
+		ShutdownPhaseStopAccepting,
+		ShutdownPhaseLeaveCluster,
+		ShutdownPhaseStopRemote,
+		ShutdownPhaseFlushPersistence,
+	}, order)
+}
+
+func TestCoordinatedShutdown_StopsUserActors(t *testing.T) {
+	system := NewActorSystem()
+
+	stopped := make(chan struct{})
+	props := PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(*Stopped); ok {
+			close(stopped)
+		}
+	})
+	pid := system.Root.Spawn(props)
+
+	var stoppedBeforeRemote bool
+	system.CoordinatedShutdown().AddTask(ShutdownPhaseStopRemote, "remote", 0, func(_ context.Context) error {
+		select {
+		case <-stopped:
+			stoppedBeforeRemote = true
+		default:
+		}
+		return nil
+	})
+
+	assert.NoError(t, system.Shutdown())
+	assert.True(t, stoppedBeforeRemote)
+
+	_, found := system.ProcessRegistry.Get(pid)
+	assert.False(t, found)
+}
+
+func TestCoordinatedShutdown_SkipsSystemActors(t *testing.T) {
+	system := NewActorSystem()
+
+	pid := system.Root.Spawn(PropsFromFunc(nullReceive, WithSystemActor()))
+
+	assert.NoError(t, system.Shutdown())
+
+	_, found := system.ProcessRegistry.Get(pid)
+	assert.True(t, found)
+}
+
+func TestCoordinatedShutdown_StopUserActorsHonorsTimeout(t *testing.T) {
+	system := NewActorSystem()
+
+	release := make(chan struct{})
+	defer close(release)
+	pid := system.Root.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			<-release
+		}
+	}))
+	system.Root.Send(pid, "hang")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	assert.ErrorIs(t, system.CoordinatedShutdown().stopUserActors(ctx), context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestCoordinatedShutdown_ReportsFailedTasks(t *testing.T) {
+	system := NewActorSystem()
+
+	failure := errors.New("boom")
+	cs := system.CoordinatedShutdown()
+	cs.AddTask(ShutdownPhaseStopRemote, "failing", 0, func(_ context.Context) error {
+		return failure
+	})
+	cs.AddTask(ShutdownPhaseFlushPersistence, "slow", 10*time.Millisecond, func(_ context.Context) error {
+		<-time.After(time.Second)
+		return nil
+	})
+
+	err := system.Shutdown()
+	assert.ErrorIs(t, err, failure)
+	assert.ErrorIs(t, err, ErrShutdownTaskTimeout)
+	assert.True(t, system.IsStopped())
+
+	// subsequent calls return the result of the first run
+	assert.Equal(t, err, system.Shutdown())
+}
+
+func TestCoordinatedShutdown_ShutdownAsyncFromActor(t *testing.T) {
+	system := NewActorSystem()
+
+	done := make(chan error, 1)
+	pid := system.Root.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			go func(res <-chan error) { done <- <-res }(ctx.ActorSystem().ShutdownAsync())
+		}
+	}))
+	system.Root.Send(pid, "shutdown")
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "shutdown did not complete")
+	}
+	assert.True(t, system.IsStopped())
+
+	_, found := system.ProcessRegistry.Get(pid)
+	assert.False(t, found)
+}
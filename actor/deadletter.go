@@ -13,6 +13,7 @@ import (
 
 type deadLetterProcess struct {
 	actorSystem *ActorSystem
+	store       *DeadLetterStore
 }
 
 var _ Process = &deadLetterProcess{}
@@ -22,6 +23,10 @@ func NewDeadLetter(actorSystem *ActorSystem) *deadLetterProcess {
 		actorSystem: actorSystem,
 	}
 
+	if actorSystem.Config.DeadLetterStoreSize > 0 {
+		dp.store = NewDeadLetterStore(actorSystem.Config.DeadLetterStoreSize)
+	}
+
 	shouldThrottle := NewThrottle(actorSystem.Config.DeadLetterThrottleCount, actorSystem.Config.DeadLetterThrottleInterval, func(i int32) {
 		actorSystem.Logger().Info("[DeadLetter]", slog.Int64("throttled", int64(i)))
 	})
@@ -68,9 +73,10 @@ func NewDeadLetter(actorSystem *ActorSystem) *deadLetterProcess {
 
 // A DeadLetterEvent is published via event.Publish when a message is sent to a nonexistent PID
 type DeadLetterEvent struct {
-	PID     *PID        // The invalid process, to which the message was sent
-	Message interface{} // The message that could not be delivered
-	Sender  *PID        // the process that sent the Message
+	PID     *PID                  // The invalid process, to which the message was sent
+	Message interface{}           // The message that could not be delivered
+	Sender  *PID                  // the process that sent the Message
+	Header  ReadonlyMessageHeader // the headers of the Message, if any
 }
 
 func (dp *deadLetterProcess) SendUserMessage(pid *PID, message interface{}) {
@@ -86,11 +92,17 @@ func (dp *deadLetterProcess) SendUserMessage(pid *PID, message interface{}) {
 			instruments.DeadLetterCount.Add(ctx, 1, metric.WithAttributes(labels...))
 		}
 	}
-	_, msg, sender := UnwrapEnvelope(message)
+	header, msg, sender := UnwrapEnvelope(message)
+	// the responses to dead letters sent by processes that are gone are not worth keeping
+	if _, ok := msg.(*DeadLetterResponse); !ok && dp.store != nil {
+		dp.store.add(pid, msg, sender, header)
+	}
+
 	dp.actorSystem.EventStream.Publish(&DeadLetterEvent{
 		PID:     pid,
 		Message: msg,
 		Sender:  sender,
+		Header:  header,
 	})
 }
 
@@ -0,0 +1,196 @@
+package actor
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DeadLetterEntry is a user message captured by a DeadLetterStore
+type DeadLetterEntry struct {
+	Target    *PID              // the process the message was sent to
+	Sender    *PID              // the process that sent the message, if any
+	Message   interface{}       // the message that could not be delivered
+	Header    map[string]string // the headers of the message, if any
+	Timestamp time.Time         // when the message reached the dead letter process
+}
+
+// DeadLetterFilter selects dead letters in a query or a replay
+type DeadLetterFilter func(entry *DeadLetterEntry) bool
+
+// TargetMatching selects the dead letters sent to a PID whose ID matches the pattern, see path.Match for the
+// pattern syntax, e.g. "orders/*"
+func TargetMatching(pattern string) DeadLetterFilter {
+	return func(entry *DeadLetterEntry) bool {
+		matched, _ := path.Match(pattern, entry.Target.GetId())
+
+		return matched
+	}
+}
+
+// SenderMatching selects the dead letters sent by a PID whose ID matches the pattern, see path.Match for the
+// pattern syntax
+func SenderMatching(pattern string) DeadLetterFilter {
+	return func(entry *DeadLetterEntry) bool {
+		if entry.Sender == nil {
+			return false
+		}
+
+		matched, _ := path.Match(pattern, entry.Sender.GetId())
+
+		return matched
+	}
+}
+
+// MessageTypeNamed selects the dead letters whose message type has the given name, e.g. "main.Hello" for both
+// main.Hello and *main.Hello
+func MessageTypeNamed(name string) DeadLetterFilter {
+	return func(entry *DeadLetterEntry) bool {
+		return strings.TrimPrefix(fmt.Sprintf("%T", entry.Message), "*") == name
+	}
+}
+
+// MessageOfType selects the dead letters whose message is a T
+func MessageOfType[T any]() DeadLetterFilter {
+	return func(entry *DeadLetterEntry) bool {
+		_, ok := entry.Message.(T)
+
+		return ok
+	}
+}
+
+// CapturedSince selects the dead letters captured at or after t
+func CapturedSince(t time.Time) DeadLetterFilter {
+	return func(entry *DeadLetterEntry) bool {
+		return !entry.Timestamp.Before(t)
+	}
+}
+
+// DeadLetterStore keeps the last dead letters sent to the dead letter process, the oldest ones are dropped
+// once the store is full
+type DeadLetterStore struct {
+	mu       sync.RWMutex
+	entries  []DeadLetterEntry
+	next     int
+	capacity int
+}
+
+// NewDeadLetterStore creates a store keeping up to capacity dead letters
+func NewDeadLetterStore(capacity int) *DeadLetterStore {
+	return &DeadLetterStore{
+		entries:  make([]DeadLetterEntry, 0, capacity),
+		capacity: capacity,
+	}
+}
+
+func (s *DeadLetterStore) add(target *PID, message interface{}, sender *PID, header ReadonlyMessageHeader) {
+	entry := DeadLetterEntry{
+		Target:    target,
+		Sender:    sender,
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+
+	if header != nil && header.Length() > 0 {
+		entry.Header = header.ToMap()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.entries) < s.capacity {
+		s.entries = append(s.entries, entry)
+		return
+	}
+
+	s.entries[s.next] = entry
+	s.next = (s.next + 1) % s.capacity
+}
+
+// Len returns the number of dead letters in the store
+func (s *DeadLetterStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.entries)
+}
+
+// Query returns the dead letters all the filters select, oldest first
+func (s *DeadLetterStore) Query(filters ...DeadLetterFilter) []DeadLetterEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result []DeadLetterEntry
+	s.each(func(entry *DeadLetterEntry) {
+		if matches(entry, filters) {
+			result = append(result, *entry)
+		}
+	})
+
+	return result
+}
+
+// Clear removes all the dead letters from the store
+func (s *DeadLetterStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = make([]DeadLetterEntry, 0, s.capacity)
+	s.next = 0
+}
+
+// Replay sends the dead letters all the filters select to target, oldest first, with their original sender and
+// headers. The replayed dead letters are removed from the store, the number of them is returned.
+func (s *DeadLetterStore) Replay(context SenderContext, target *PID, filters ...DeadLetterFilter) int {
+	s.mu.Lock()
+
+	var replayed []DeadLetterEntry
+
+	kept := make([]DeadLetterEntry, 0, s.capacity)
+	s.each(func(entry *DeadLetterEntry) {
+		if matches(entry, filters) {
+			replayed = append(replayed, *entry)
+		} else {
+			kept = append(kept, *entry)
+		}
+	})
+
+	s.entries = kept
+	s.next = 0
+	s.mu.Unlock()
+
+	// sent without holding the lock, a replayed message may end up in the dead letters again
+	for _, entry := range replayed {
+		if entry.Sender == nil && entry.Header == nil {
+			context.Send(target, entry.Message)
+			continue
+		}
+
+		context.Send(target, &MessageEnvelope{
+			Header:  entry.Header,
+			Message: entry.Message,
+			Sender:  entry.Sender,
+		})
+	}
+
+	return len(replayed)
+}
+
+// each calls f for every dead letter, oldest first. The caller holds the lock.
+func (s *DeadLetterStore) each(f func(entry *DeadLetterEntry)) {
+	for i := range s.entries {
+		f(&s.entries[(s.next+i)%len(s.entries)])
+	}
+}
+
+func matches(entry *DeadLetterEntry, filters []DeadLetterFilter) bool {
+	for _, filter := range filters {
+		if !filter(entry) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -35,3 +35,72 @@ func TestDeadLetterWatchRespondsWithTerminate(t *testing.T) {
 	pid.sendSystemMessage(system, &Watch{Watcher: f.PID()})
 	assertFutureSuccess(f, t)
 }
+
+func TestDeadLetterStore_CapturesLastEntries(t *testing.T) {
+	system := NewActorSystem(WithDeadLetterStore(2))
+
+	pid := system.NewLocalPID("orders/missing")
+	sender := system.NewLocalPID("sender")
+	system.Root.Send(pid, "first")
+	system.Root.Send(pid, &MessageEnvelope{Header: messageHeader{"trace": "1"}, Message: "second", Sender: sender})
+	system.Root.Send(system.NewLocalPID("users/missing"), 3)
+
+	entries := system.DeadLetterStore().Query()
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "second", entries[0].Message)
+	assert.Equal(t, sender, entries[0].Sender)
+	assert.Equal(t, map[string]string{"trace": "1"}, entries[0].Header)
+	assert.False(t, entries[0].Timestamp.IsZero())
+	assert.Equal(t, 3, entries[1].Message)
+}
+
+func TestDeadLetterStore_Query(t *testing.T) {
+	system := NewActorSystem(WithDeadLetterStore(10))
+
+	system.Root.Send(system.NewLocalPID("orders/1"), "a")
+	system.Root.Send(system.NewLocalPID("orders/2"), 1)
+	system.Root.Send(system.NewLocalPID("users/1"), "b")
+
+	store := system.DeadLetterStore()
+	assert.Len(t, store.Query(TargetMatching("orders/*")), 2)
+	assert.Len(t, store.Query(MessageOfType[string]()), 2)
+	assert.Len(t, store.Query(MessageTypeNamed("int")), 1)
+	assert.Len(t, store.Query(TargetMatching("orders/*"), MessageOfType[string]()), 1)
+}
+
+func TestDeadLetterStore_Replay(t *testing.T) {
+	system := NewActorSystem(WithDeadLetterStore(10))
+
+	sender := system.NewLocalPID("sender")
+	system.Root.Send(system.NewLocalPID("orders/1"), &MessageEnvelope{Header: messageHeader{"trace": "1"}, Message: "a", Sender: sender})
+	system.Root.Send(system.NewLocalPID("users/1"), "b")
+
+	type replayedMessage struct {
+		message interface{}
+		sender  *PID
+		trace   string
+	}
+
+	received := make(chan replayedMessage, 1)
+	target := system.Root.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			received <- replayedMessage{ctx.Message(), ctx.Sender(), ctx.MessageHeader().Get("trace")}
+		}
+	}))
+
+	replayed := system.DeadLetterStore().Replay(system.Root, target, TargetMatching("orders/*"))
+	assert.Equal(t, 1, replayed)
+
+	msg := <-received
+	assert.Equal(t, "a", msg.message)
+	assert.Equal(t, sender, msg.sender)
+	assert.Equal(t, "1", msg.trace)
+
+	entries := system.DeadLetterStore().Query()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "b", entries[0].Message)
+}
+
+func TestDeadLetterStore_DisabledByDefault(t *testing.T) {
+	assert.Nil(t, NewActorSystem().DeadLetterStore())
+}
@@ -1,5 +1,17 @@
 package actor
 
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/asynkron/protoactor-go/internal/queue/goring"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
 type Dispatcher interface {
 	Schedule(fn func())
 	Throughput() int
@@ -36,3 +48,235 @@ func (d synchronizedDispatcher) Throughput() int {
 func NewSynchronizedDispatcher(throughput int) Dispatcher {
 	return synchronizedDispatcher(throughput)
 }
+
+// fairDispatcher is implemented by dispatchers sharing a bounded set of goroutines between mailboxes.
+// A mailbox hands the goroutine back to the dispatcher after Throughput messages instead of yielding
+// to the Go scheduler, so that a busy actor cannot starve the other actors of the dispatcher.
+type fairDispatcher interface {
+	Dispatcher
+	fair()
+}
+
+// latencyRecorder is implemented by dispatchers that record the time a scheduled mailbox waits
+// for a goroutine into metrics.ActorMetrics.ThreadPoolLatency.
+type latencyRecorder interface {
+	recordLatency(histogram metric.Int64Histogram, labels []attribute.KeyValue)
+}
+
+type dispatcherLatency struct {
+	name      string
+	histogram atomic.Pointer[metric.Int64Histogram]
+	labels    []attribute.KeyValue
+	once      sync.Once
+}
+
+func (d *dispatcherLatency) recordLatency(histogram metric.Int64Histogram, labels []attribute.KeyValue) {
+	d.once.Do(func() {
+		d.labels = append(append([]attribute.KeyValue{}, labels...), attribute.String("dispatcher", d.name))
+		d.histogram.Store(&histogram)
+	})
+}
+
+func (d *dispatcherLatency) observe(scheduled time.Time) {
+	if histogram := d.histogram.Load(); histogram != nil {
+		(*histogram).Record(context.Background(), time.Since(scheduled).Milliseconds(), metric.WithAttributes(d.labels...))
+	}
+}
+
+type dispatcherTask struct {
+	fn        func()
+	scheduled time.Time
+}
+
+// WorkerPoolDispatcher runs all its actors on a fixed number of goroutines, see NewWorkerPoolDispatcher
+type WorkerPoolDispatcher struct {
+	dispatcherLatency
+	throughput int
+	queue      *goring.Queue
+	popMu      sync.Mutex // the queue supports a single consumer
+	wake       chan struct{}
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+var (
+	_ fairDispatcher  = &WorkerPoolDispatcher{}
+	_ latencyRecorder = &WorkerPoolDispatcher{}
+)
+
+// NewWorkerPoolDispatcher creates a dispatcher running all its actors on a fixed number of goroutines.
+// Scheduled mailboxes are served in FIFO order and each gets to process at most throughput messages
+// before it is put at the back of the queue. The workers run until the dispatcher is closed.
+func NewWorkerPoolDispatcher(name string, workers int, throughput int) *WorkerPoolDispatcher {
+	if workers < 1 {
+		workers = 1
+	}
+
+	d := &WorkerPoolDispatcher{
+		dispatcherLatency: dispatcherLatency{name: name},
+		throughput:        throughput,
+		queue:             goring.New(int64(workers)),
+		wake:              make(chan struct{}, workers),
+		done:              make(chan struct{}),
+	}
+
+	for i := 0; i < workers; i++ {
+		go d.work()
+	}
+
+	return d
+}
+
+func (d *WorkerPoolDispatcher) Schedule(fn func()) {
+	d.queue.Push(dispatcherTask{fn: fn, scheduled: time.Now()})
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+		// every worker already has a pending wake-up
+	}
+
+	if d.isClosed() {
+		// the workers may be gone, e.g. the actors still get their Stopped message
+		for task, ok := d.pop(); ok; task, ok = d.pop() {
+			go task.fn()
+		}
+	}
+}
+
+func (d *WorkerPoolDispatcher) Throughput() int {
+	return d.throughput
+}
+
+// Close stops the workers once they processed the scheduled mailboxes. The actors using the dispatcher
+// should be stopped first, the mailboxes scheduled after Close run on their own goroutine.
+func (d *WorkerPoolDispatcher) Close() {
+	d.closeOnce.Do(func() {
+		close(d.done)
+	})
+}
+
+func (d *WorkerPoolDispatcher) isClosed() bool {
+	select {
+	case <-d.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *WorkerPoolDispatcher) fair() {}
+
+func (d *WorkerPoolDispatcher) pop() (dispatcherTask, bool) {
+	d.popMu.Lock()
+	defer d.popMu.Unlock()
+
+	item, ok := d.queue.Pop()
+	if !ok {
+		return dispatcherTask{}, false
+	}
+
+	return item.(dispatcherTask), true
+}
+
+func (d *WorkerPoolDispatcher) work() {
+	for {
+		task, ok := d.pop()
+		if !ok {
+			select {
+			case <-d.wake:
+				continue
+			case <-d.done:
+				return
+			}
+		}
+
+		d.observe(task.scheduled)
+		task.fn()
+	}
+}
+
+type pinnedDispatcher struct {
+	name       string
+	throughput int
+}
+
+// NewPinnedDispatcher creates a dispatcher giving every actor spawned with it a dedicated goroutine,
+// locked to its OS thread, for the whole life of the actor. Use it for actors wrapping cgo or otherwise
+// thread-affine libraries.
+func NewPinnedDispatcher(name string, throughput int) Dispatcher {
+	return &pinnedDispatcher{
+		name:       name,
+		throughput: throughput,
+	}
+}
+
+// Schedule is only used when the dispatcher is used outside an actor, actors get their own pinnedActorDispatcher
+func (d *pinnedDispatcher) Schedule(fn func()) {
+	go fn()
+}
+
+func (d *pinnedDispatcher) Throughput() int {
+	return d.throughput
+}
+
+func (d *pinnedDispatcher) pin() *pinnedActorDispatcher {
+	pd := &pinnedActorDispatcher{
+		dispatcherLatency: dispatcherLatency{name: d.name},
+		throughput:        d.throughput,
+		tasks:             make(chan dispatcherTask, 1),
+		done:              make(chan struct{}),
+	}
+
+	go pd.work()
+
+	return pd
+}
+
+type pinnedActorDispatcher struct {
+	dispatcherLatency
+	throughput int
+	tasks      chan dispatcherTask
+	done       chan struct{}
+	stopOnce   sync.Once
+}
+
+var (
+	_ fairDispatcher  = &pinnedActorDispatcher{}
+	_ latencyRecorder = &pinnedActorDispatcher{}
+)
+
+func (d *pinnedActorDispatcher) Schedule(fn func()) {
+	select {
+	case d.tasks <- dispatcherTask{fn: fn, scheduled: time.Now()}:
+	case <-d.done:
+	}
+}
+
+func (d *pinnedActorDispatcher) Throughput() int {
+	return d.throughput
+}
+
+func (d *pinnedActorDispatcher) fair() {}
+
+// stop releases the goroutine once the current task has completed
+func (d *pinnedActorDispatcher) stop() {
+	d.stopOnce.Do(func() {
+		close(d.done)
+	})
+}
+
+func (d *pinnedActorDispatcher) work() {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	for {
+		select {
+		case task := <-d.tasks:
+			d.observe(task.scheduled)
+			task.fn()
+		case <-d.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package actor
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func goroutineID() string {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+
+	return string(bytes.Fields(buf)[1])
+}
+
+func TestWorkerPoolDispatcher_ProcessesAllMessages(t *testing.T) {
+	dispatcher := NewWorkerPoolDispatcher("test", 2, 10)
+
+	var wg sync.WaitGroup
+	props := PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(int); ok {
+			wg.Done()
+		}
+	}, WithDispatcher(dispatcher))
+
+	pids := make([]*PID, 10)
+	for i := range pids {
+		pids[i] = rootContext.Spawn(props)
+	}
+
+	for i := 0; i < 100; i++ {
+		for _, pid := range pids {
+			wg.Add(1)
+			rootContext.Send(pid, i)
+		}
+	}
+
+	waitTimeout(t, &wg)
+
+	for _, pid := range pids {
+		_ = rootContext.StopFuture(pid).Wait()
+	}
+}
+
+// goroutines counts the goroutines whose stack contains the given call
+func goroutines(call string) int {
+	buf := make([]byte, 1<<20)
+	for n := runtime.Stack(buf, true); ; n = runtime.Stack(buf, true) {
+		if n < len(buf) {
+			return bytes.Count(buf[:n], []byte(call))
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// workers counts the goroutines running the work loop of the dispatcher
+func workers(d *WorkerPoolDispatcher) int {
+	return goroutines(fmt.Sprintf("(*WorkerPoolDispatcher).work(%p)", d))
+}
+
+func TestWorkerPoolDispatcher_Close(t *testing.T) {
+	dispatcher := NewWorkerPoolDispatcher("test", 4, 10)
+	assert.Eventually(t, func() bool {
+		return workers(dispatcher) == 4
+	}, time.Second, 10*time.Millisecond)
+
+	dispatcher.Close()
+	assert.Eventually(t, func() bool {
+		return workers(dispatcher) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestWorkerPoolDispatcher_ServesActorsAfterClose(t *testing.T) {
+	dispatcher := NewWorkerPoolDispatcher("test", 1, 10)
+	dispatcher.Close()
+
+	received := make(chan struct{}, 1)
+	pid := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			received <- struct{}{}
+		}
+	}, WithDispatcher(dispatcher)))
+
+	rootContext.Send(pid, "hello")
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		assert.Fail(t, "message not processed")
+	}
+	assert.NoError(t, rootContext.StopFuture(pid).Wait())
+}
+
+func TestWorkerPoolDispatcher_IsFair(t *testing.T) {
+	dispatcher := NewWorkerPoolDispatcher("test", 1, 1)
+
+	var (
+		mu    sync.Mutex
+		order []string
+		wg    sync.WaitGroup
+	)
+	props := PropsFromFunc(func(ctx Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			mu.Lock()
+			order = append(order, msg)
+			mu.Unlock()
+			wg.Done()
+		}
+	}, WithDispatcher(dispatcher))
+
+	a := rootContext.Spawn(props)
+	b := rootContext.Spawn(props)
+
+	// block the only worker so both mailboxes fill up before processing starts
+	block := make(chan struct{})
+	dispatcher.Schedule(func() { <-block })
+
+	wg.Add(8)
+	for i := 0; i < 4; i++ {
+		rootContext.Send(a, "a")
+		rootContext.Send(b, "b")
+	}
+	close(block)
+
+	waitTimeout(t, &wg)
+
+	// a busy mailbox hands the worker back after its throughput, so "a" cannot run to completion first
+	assert.NotEqual(t, []string{"a", "a", "a", "a", "b", "b", "b", "b"}, order)
+	assert.Len(t, order, 8)
+}
+
+func TestPinnedDispatcher_RunsActorOnDedicatedGoroutine(t *testing.T) {
+	dispatcher := NewPinnedDispatcher("test", 1)
+
+	ids := make(chan string, 100)
+	props := PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(int); ok {
+			ids <- goroutineID()
+		}
+	}, WithDispatcher(dispatcher))
+
+	a := rootContext.Spawn(props)
+	b := rootContext.Spawn(props)
+
+	for i := 0; i < 20; i++ {
+		rootContext.Send(a, i)
+		time.Sleep(time.Millisecond)
+	}
+
+	first := <-ids
+	for i := 1; i < 20; i++ {
+		assert.Equal(t, first, <-ids)
+	}
+
+	rootContext.Send(b, 0)
+	assert.NotEqual(t, first, <-ids)
+
+	_ = rootContext.StopFuture(a).Wait()
+	_ = rootContext.StopFuture(b).Wait()
+}
+
+func TestPinnedDispatcher_NameExistsDoesNotPin(t *testing.T) {
+	props := PropsFromFunc(nullReceive, WithDispatcher(NewPinnedDispatcher("test", 1)))
+
+	pid, err := rootContext.SpawnNamed(props, "pinned")
+	assert.NoError(t, err)
+	defer func() { _ = rootContext.StopFuture(pid).Wait() }()
+
+	pinned := goroutines("(*pinnedDispatcher).pin.gowrap")
+	assert.Positive(t, pinned)
+	_, err = rootContext.SpawnNamed(props, "pinned")
+	assert.ErrorIs(t, err, ErrNameExists)
+	assert.LessOrEqual(t, goroutines("(*pinnedDispatcher).pin.gowrap"), pinned)
+}
+
+func waitTimeout(t *testing.T, wg *sync.WaitGroup) {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(testTimeout):
+		assert.Fail(t, "timed out waiting for messages")
+	}
+}
@@ -0,0 +1,184 @@
+package actor
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// StateFunc handles a message in a state of an FSM and returns the next state and its data. Returning the
+// current state stays in it without running the transition hooks.
+type StateFunc[S comparable, D any] func(ctx Context, data D) (S, D)
+
+// StateOption configures a state of an FSM
+type StateOption func(state *stateConfig)
+
+// WithStateTimeout sends a *ReceiveTimeout to the state handler when no message was received for the duration
+// while in the state
+func WithStateTimeout(timeout time.Duration) StateOption {
+	return func(state *stateConfig) {
+		state.timeout = timeout
+	}
+}
+
+type stateConfig struct {
+	timeout time.Duration
+}
+
+type fsmState[S comparable, D any] struct {
+	stateConfig
+	handler StateFunc[S, D]
+}
+
+// FSMTransition describes a transition of an FSM
+type FSMTransition[S comparable, D any] struct {
+	From    S
+	To      S
+	Data    D           // the data of the new state
+	Message interface{} // the message that caused the transition
+	Time    time.Time
+}
+
+// FSM is a finite state machine with named states of type S and state data of type D. An actor passes the
+// messages it receives to FSM.Receive, e.g.
+//
+//	fsm := actor.NewFSM[string, int]("idle", 0).
+//		When("idle", idle).
+//		When("busy", busy, actor.WithStateTimeout(time.Second))
+//
+// The FSM is not safe for concurrent use, it is meant to be used from the actor it belongs to.
+type FSM[S comparable, D any] struct {
+	state       S
+	data        D
+	states      map[S]*fsmState[S, D]
+	hooks       []func(transition *FSMTransition[S, D])
+	log         []FSMTransition[S, D]
+	logSize     int
+	logNext     int
+	timeoutDone bool
+}
+
+// NewFSM creates a state machine starting in the initial state with the given data
+func NewFSM[S comparable, D any](initial S, data D) *FSM[S, D] {
+	return &FSM[S, D]{
+		state:  initial,
+		data:   data,
+		states: make(map[S]*fsmState[S, D]),
+	}
+}
+
+// When sets the handler of the messages received in the state
+func (fsm *FSM[S, D]) When(state S, handler StateFunc[S, D], opts ...StateOption) *FSM[S, D] {
+	s := &fsmState[S, D]{handler: handler}
+	for _, opt := range opts {
+		opt(&s.stateConfig)
+	}
+
+	fsm.states[state] = s
+
+	return fsm
+}
+
+// OnTransition adds a hook called after every transition to another state
+func (fsm *FSM[S, D]) OnTransition(hook func(transition *FSMTransition[S, D])) *FSM[S, D] {
+	fsm.hooks = append(fsm.hooks, hook)
+
+	return fsm
+}
+
+// LogTransitions keeps the last size transitions, see TransitionLog
+func (fsm *FSM[S, D]) LogTransitions(size int) *FSM[S, D] {
+	fsm.logSize = size
+	fsm.log = make([]FSMTransition[S, D], 0, size)
+	fsm.logNext = 0
+
+	return fsm
+}
+
+// State returns the current state
+func (fsm *FSM[S, D]) State() S {
+	return fsm.state
+}
+
+// Data returns the data of the current state
+func (fsm *FSM[S, D]) Data() D {
+	return fsm.data
+}
+
+// TransitionLog returns the logged transitions, oldest first
+func (fsm *FSM[S, D]) TransitionLog() []FSMTransition[S, D] {
+	log := make([]FSMTransition[S, D], 0, len(fsm.log))
+	for i := range fsm.log {
+		log = append(log, fsm.log[(fsm.logNext+i)%len(fsm.log)])
+	}
+
+	return log
+}
+
+// Restore sets the state and its data without running the transition hooks, e.g. when replaying persisted
+// transitions. The timeout of the state applies from the next received message on.
+func (fsm *FSM[S, D]) Restore(state S, data D) {
+	fsm.state = state
+	fsm.data = data
+	fsm.timeoutDone = false
+}
+
+// Receive passes the message to the handler of the current state and transitions to the state it returns
+func (fsm *FSM[S, D]) Receive(ctx Context) {
+	if !fsm.timeoutDone {
+		fsm.applyTimeout(ctx)
+	}
+
+	current, ok := fsm.states[fsm.state]
+	if !ok {
+		ctx.Logger().Error("FSM has no handler for state", slog.Any("pid", ctx.Self()), slog.String("state", fmt.Sprint(fsm.state)))
+		return
+	}
+
+	next, data := current.handler(ctx, fsm.data)
+	fsm.data = data
+
+	if next == fsm.state {
+		return
+	}
+
+	transition := &FSMTransition[S, D]{
+		From:    fsm.state,
+		To:      next,
+		Data:    data,
+		Message: ctx.Message(),
+		Time:    time.Now(),
+	}
+
+	fsm.state = next
+	fsm.applyTimeout(ctx)
+	fsm.record(transition)
+
+	for _, hook := range fsm.hooks {
+		hook(transition)
+	}
+}
+
+func (fsm *FSM[S, D]) applyTimeout(ctx Context) {
+	fsm.timeoutDone = true
+
+	if s, ok := fsm.states[fsm.state]; ok && s.timeout > 0 {
+		ctx.SetReceiveTimeout(s.timeout)
+	} else if ctx.ReceiveTimeout() > 0 {
+		ctx.CancelReceiveTimeout()
+	}
+}
+
+func (fsm *FSM[S, D]) record(transition *FSMTransition[S, D]) {
+	if fsm.logSize <= 0 {
+		return
+	}
+
+	if len(fsm.log) < fsm.logSize {
+		fsm.log = append(fsm.log, *transition)
+		return
+	}
+
+	fsm.log[fsm.logNext] = *transition
+	fsm.logNext = (fsm.logNext + 1) % fsm.logSize
+}
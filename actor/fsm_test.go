@@ -0,0 +1,122 @@
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	fsmCoin  struct{}
+	fsmPush  struct{}
+	fsmQuery struct{}
+)
+
+type turnstile struct {
+	fsm     *FSM[string, int]
+	entered []string
+}
+
+func newTurnstile(timeout time.Duration, notify ...chan<- string) *turnstile {
+	t := &turnstile{}
+	t.fsm = NewFSM[string, int]("locked", 0).
+		When("locked", func(ctx Context, coins int) (string, int) {
+			if _, ok := ctx.Message().(*fsmCoin); ok {
+				return "unlocked", coins + 1
+			}
+			return "locked", coins
+		}).
+		When("unlocked", func(ctx Context, coins int) (string, int) {
+			switch ctx.Message().(type) {
+			case *fsmPush, *ReceiveTimeout:
+				return "locked", coins
+			}
+			return "unlocked", coins
+		}, WithStateTimeout(timeout)).
+		OnTransition(func(transition *FSMTransition[string, int]) {
+			t.entered = append(t.entered, transition.To)
+			for _, c := range notify {
+				c <- transition.To
+			}
+		}).
+		LogTransitions(2)
+
+	return t
+}
+
+func (t *turnstile) Receive(ctx Context) {
+	if _, ok := ctx.Message().(*fsmQuery); ok {
+		ctx.Respond(&turnstileSnapshot{
+			state:   t.fsm.State(),
+			coins:   t.fsm.Data(),
+			entered: append([]string(nil), t.entered...),
+			log:     t.fsm.TransitionLog(),
+		})
+		return
+	}
+
+	t.fsm.Receive(ctx)
+}
+
+type turnstileSnapshot struct {
+	state   string
+	coins   int
+	entered []string
+	log     []FSMTransition[string, int]
+}
+
+func queryTurnstile(t *testing.T, pid *PID) *turnstileSnapshot {
+	res, err := rootContext.RequestFuture(pid, &fsmQuery{}, testTimeout).Result()
+	assert.NoError(t, err)
+
+	return res.(*turnstileSnapshot)
+}
+
+func TestFSM_Transitions(t *testing.T) {
+	pid := rootContext.Spawn(PropsFromProducer(func() Actor { return newTurnstile(time.Hour) }))
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, &fsmCoin{})
+	rootContext.Send(pid, &fsmCoin{})
+	rootContext.Send(pid, &fsmPush{})
+	rootContext.Send(pid, &fsmCoin{})
+
+	ts := queryTurnstile(t, pid)
+	assert.Equal(t, "unlocked", ts.state)
+	assert.Equal(t, 2, ts.coins)
+	assert.Equal(t, []string{"unlocked", "locked", "unlocked"}, ts.entered)
+
+	log := ts.log
+	assert.Len(t, log, 2)
+	assert.Equal(t, "unlocked", log[0].From)
+	assert.Equal(t, "locked", log[0].To)
+	assert.IsType(t, &fsmPush{}, log[0].Message)
+	assert.Equal(t, "locked", log[1].From)
+	assert.Equal(t, "unlocked", log[1].To)
+}
+
+func TestFSM_StateTimeout(t *testing.T) {
+	entered := make(chan string, 2)
+	pid := rootContext.Spawn(PropsFromProducer(func() Actor { return newTurnstile(20*time.Millisecond, entered) }))
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, &fsmCoin{})
+
+	assert.Equal(t, "unlocked", <-entered)
+	select {
+	case state := <-entered:
+		assert.Equal(t, "locked", state)
+	case <-time.After(testTimeout):
+		t.Fatal("state did not time out")
+	}
+}
+
+func TestFSM_RestoreSkipsHooks(t *testing.T) {
+	ts := newTurnstile(time.Hour)
+	ts.fsm.Restore("unlocked", 3)
+
+	assert.Equal(t, "unlocked", ts.fsm.State())
+	assert.Equal(t, 3, ts.fsm.Data())
+	assert.Empty(t, ts.entered)
+}
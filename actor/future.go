@@ -3,6 +3,7 @@ package actor
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"sync"
 	"sync/atomic"
@@ -20,6 +21,22 @@ var ErrTimeout = errors.New("future: timeout")
 // ErrDeadLetter is meaning you request to a unreachable PID.
 var ErrDeadLetter = errors.New("future: dead letter")
 
+// ResponseTimeoutError is the response of a process that forwards requests, e.g. a router, when no response
+// arrived within its timeout. It fails the future of the sender with an error matching ErrTimeout.
+type ResponseTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *ResponseTimeoutError) Error() string {
+	return fmt.Sprintf("future: no response within %v", e.Timeout)
+}
+
+func (e *ResponseTimeoutError) Unwrap() error {
+	return ErrTimeout
+}
+
+func (e *ResponseTimeoutError) futureError() {}
+
 // NewFuture creates and returns a new actor.Future with a timeout of duration d.
 func NewFuture(actorSystem *ActorSystem, d time.Duration) *Future {
 	ref := &futureProcess{Future{actorSystem: actorSystem, cond: sync.NewCond(&sync.Mutex{})}}
@@ -142,6 +159,13 @@ func (f *Future) continueWith(continuation func(res interface{}, err error)) {
 	}
 }
 
+// futureError is implemented by the errors a process responds with to fail the future of the sender,
+// any other error is delivered as the result of the future.
+type futureError interface {
+	error
+	futureError()
+}
+
 // futureProcess is a struct carrying a response PID and a channel where the response is placed.
 type futureProcess struct {
 	Future
@@ -154,10 +178,14 @@ func (ref *futureProcess) SendUserMessage(pid *PID, message interface{}) {
 
 	_, msg, _ := UnwrapEnvelope(message)
 
-	if _, ok := msg.(*DeadLetterResponse); ok {
+	switch m := msg.(type) {
+	case *DeadLetterResponse:
 		ref.result = nil
 		ref.err = ErrDeadLetter
-	} else {
+	case futureError:
+		ref.result = nil
+		ref.err = m
+	default:
 		ref.result = msg
 	}
 
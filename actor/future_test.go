@@ -100,6 +100,23 @@ func TestFuture_Result_DeadLetterResponse(t *testing.T) {
 	a.Nil(resp)
 }
 
+func TestFuture_Result_FutureError(t *testing.T) {
+	for _, ferr := range []futureError{&CircuitOpenError{Name: "circuit"}, &RateLimitedError{}, &ResponseTimeoutError{Timeout: time.Second}} {
+		future := NewFuture(system, 1*time.Second)
+		rootContext.Send(future.PID(), ferr)
+		resp, err := future.Result()
+		assert.Equal(t, ferr, err)
+		assert.Nil(t, resp)
+	}
+
+	// other errors are responses
+	future := NewFuture(system, 1*time.Second)
+	rootContext.Send(future.PID(), ErrTimeout)
+	resp, err := future.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, ErrTimeout, resp)
+}
+
 func TestFuture_Result_Timeout(t *testing.T) {
 	a := assert.New(t)
 
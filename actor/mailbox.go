@@ -102,7 +102,7 @@ func (m *defaultMailbox) schedule() {
 
 func (m *defaultMailbox) processMessages() {
 process:
-	m.run()
+	yielded := m.run()
 
 	// set mailbox to idle
 	atomic.StoreInt32(&m.schedulerStatus, idle)
@@ -112,6 +112,11 @@ process:
 	if sys > 0 || (atomic.LoadInt32(&m.suspended) == 0 && user > 0) {
 		// try setting the mailbox back to running
 		if atomic.CompareAndSwapInt32(&m.schedulerStatus, idle, running) {
+			if yielded {
+				// give the other mailboxes of a fair dispatcher their turn
+				m.dispatcher.Schedule(m.processMessages)
+				return
+			}
 			//	fmt.Printf("looping %v %v %v\n", sys, user, m.suspended)
 			goto process
 		}
@@ -124,7 +129,9 @@ process:
 	}
 }
 
-func (m *defaultMailbox) run() {
+// run processes messages until the mailbox is empty or suspended, it returns true when it stopped
+// early to hand the goroutine back to a fair dispatcher.
+func (m *defaultMailbox) run() (yielded bool) {
 	var msg interface{}
 
 	defer func() {
@@ -133,9 +140,13 @@ func (m *defaultMailbox) run() {
 		}
 	}()
 
+	_, fair := m.dispatcher.(fairDispatcher)
 	i, t := 0, m.dispatcher.Throughput()
 	for {
 		if i > t {
+			if fair {
+				return true
+			}
 			i = 0
 			runtime.Gosched()
 		}
@@ -68,6 +68,9 @@ type Failure struct {
 	Message      interface{}
 }
 
+// backoffDeadLetter is sent to a failed child whose restart is delayed, user messages are dead-lettered until the restart
+type backoffDeadLetter struct{}
+
 type continuation struct {
 	message interface{}
 	f       func()
@@ -84,25 +87,27 @@ func (*Stopping) AutoReceiveMessage()   {}
 func (*Stopped) AutoReceiveMessage()    {}
 func (*PoisonPill) AutoReceiveMessage() {}
 
-func (*Started) SystemMessage()      {}
-func (*Stop) SystemMessage()         {}
-func (*Watch) SystemMessage()        {}
-func (*Unwatch) SystemMessage()      {}
-func (*Terminated) SystemMessage()   {}
-func (*Failure) SystemMessage()      {}
-func (*Restart) SystemMessage()      {}
-func (*continuation) SystemMessage() {}
+func (*Started) SystemMessage()           {}
+func (*Stop) SystemMessage()              {}
+func (*Watch) SystemMessage()             {}
+func (*Unwatch) SystemMessage()           {}
+func (*Terminated) SystemMessage()        {}
+func (*Failure) SystemMessage()           {}
+func (*Restart) SystemMessage()           {}
+func (*continuation) SystemMessage()      {}
+func (*backoffDeadLetter) SystemMessage() {}
 
 var (
-	restartingMessage     AutoReceiveMessage = &Restarting{}
-	stoppingMessage       AutoReceiveMessage = &Stopping{}
-	stoppedMessage        AutoReceiveMessage = &Stopped{}
-	poisonPillMessage     AutoReceiveMessage = &PoisonPill{}
-	receiveTimeoutMessage interface{}        = &ReceiveTimeout{}
-	restartMessage        SystemMessage      = &Restart{}
-	startedMessage        SystemMessage      = &Started{}
-	stopMessage           SystemMessage      = &Stop{}
-	resumeMailboxMessage  MailboxMessage     = &ResumeMailbox{}
-	suspendMailboxMessage MailboxMessage     = &SuspendMailbox{}
-	_                     AutoRespond        = &Touch{}
+	restartingMessage        AutoReceiveMessage = &Restarting{}
+	stoppingMessage          AutoReceiveMessage = &Stopping{}
+	stoppedMessage           AutoReceiveMessage = &Stopped{}
+	poisonPillMessage        AutoReceiveMessage = &PoisonPill{}
+	receiveTimeoutMessage    interface{}        = &ReceiveTimeout{}
+	restartMessage           SystemMessage      = &Restart{}
+	startedMessage           SystemMessage      = &Started{}
+	stopMessage              SystemMessage      = &Stop{}
+	backoffDeadLetterMessage SystemMessage      = &backoffDeadLetter{}
+	resumeMailboxMessage     MailboxMessage     = &ResumeMailbox{}
+	suspendMailboxMessage    MailboxMessage     = &SuspendMailbox{}
+	_                        AutoRespond        = &Touch{}
 )
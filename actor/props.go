@@ -8,6 +8,7 @@ import (
 
 	"github.com/asynkron/protoactor-go/metrics"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -46,7 +47,6 @@ var (
 			}
 		}
 
-		dp := props.getDispatcher()
 		proc := NewActorProcess(mb)
 		pid, absent := actorSystem.ProcessRegistry.Add(proc, id)
 		if !absent {
@@ -54,6 +54,25 @@ var (
 		}
 		ctx.self = pid
 
+		// pin once the name is registered, the dedicated goroutine is stopped with the actor
+		dp := props.getDispatcher()
+		if pinned, ok := dp.(*pinnedDispatcher); ok {
+			actorDispatcher := pinned.pin()
+			ctx.ensureExtras().pinnedDispatcher = actorDispatcher
+			dp = actorDispatcher
+		}
+
+		if recorder, ok := dp.(latencyRecorder); ok && ctx.actorSystem.Config.MetricsProvider != nil {
+			sysMetrics, ok := ctx.actorSystem.Extensions.Get(extensionId).(*Metrics)
+			if ok && sysMetrics.enabled {
+				if instruments := sysMetrics.metrics.Get(metrics.InternalActorMetrics); instruments != nil {
+					recorder.recordLatency(instruments.ThreadPoolLatency, []attribute.KeyValue{
+						attribute.String("address", actorSystem.Address()),
+					})
+				}
+			}
+		}
+
 		initialize(props, ctx)
 
 		mb.RegisterHandlers(ctx, dp)
@@ -100,6 +119,12 @@ type Props struct {
 	contextDecorator        []ContextDecorator
 	contextDecoratorChain   ContextDecoratorFunc
 	onInit                  []func(ctx Context)
+	systemActor             bool
+}
+
+// Spawner returns the function used to spawn actors from the props
+func (props *Props) Spawner() SpawnFunc {
+	return props.getSpawner()
 }
 
 func (props *Props) getSpawner() SpawnFunc {
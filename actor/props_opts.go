@@ -8,6 +8,14 @@ func WithOnInit(init ...func(ctx Context)) PropsOption {
 	}
 }
 
+// WithSystemActor marks the actor as infrastructure, it is not poisoned with the user actors
+// during coordinated shutdown and is expected to be stopped by the extension owning it.
+func WithSystemActor() PropsOption {
+	return func(props *Props) {
+		props.systemActor = true
+	}
+}
+
 func WithProducer(p Producer) PropsOption {
 	return func(props *Props) {
 		props.producer = func(*ActorSystem) Actor { return p() }
@@ -0,0 +1,243 @@
+package actor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimitPolicy decides what a rate limited mailbox does with the user messages exceeding the rate
+type RateLimitPolicy int32
+
+const (
+	// RateLimitDelay keeps the excess messages, in order, until the rate allows them to be processed
+	RateLimitDelay RateLimitPolicy = iota
+	// RateLimitDrop forwards the excess messages to dead letters, requests fail fast with ErrDeadLetter
+	RateLimitDrop
+	// RateLimitReject responds to the sender of an excess message with a *RateLimitedError, the messages without
+	// a sender are forwarded to dead letters
+	RateLimitReject
+)
+
+// RateLimitedError is the response to a message rejected by a rate limited mailbox, a Future fails with it
+type RateLimitedError struct {
+	PID        *PID
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("mailbox: rate of %v exceeded, retry after %v", e.PID, e.RetryAfter)
+}
+
+func (e *RateLimitedError) futureError() {}
+
+// RateLimited wraps the mailboxes created by producer, limiting the user messages the actor processes to rate
+// per second using a token bucket holding up to burst tokens. A burst of 1 with RateLimitDelay behaves like
+// a leaky bucket.
+//
+// System messages are never limited, auto receive messages, e.g. PoisonPill, take no token and are never dropped
+// or rejected. It panics if the rate is not positive.
+func RateLimited(producer MailboxProducer, rate float64, burst int, policy RateLimitPolicy) MailboxProducer {
+	if !(rate > 0) {
+		panic(fmt.Errorf("mailbox: the rate of a rate limited mailbox must be positive, got %v", rate))
+	}
+
+	return func() Mailbox {
+		return &rateLimitedMailbox{
+			Mailbox: producer(),
+			policy:  policy,
+			bucket:  newTokenBucket(rate, burst),
+		}
+	}
+}
+
+type rateLimitedMailbox struct {
+	Mailbox
+	policy      RateLimitPolicy
+	actorSystem *ActorSystem
+	self        *PID
+
+	mu      sync.Mutex
+	bucket  *tokenBucket
+	delayed []interface{}
+	timer   *time.Timer
+	// ready are the messages the rate allows, waiting to be posted to the inner mailbox by the posting caller
+	ready   []interface{}
+	posting bool
+}
+
+func (m *rateLimitedMailbox) RegisterHandlers(invoker MessageInvoker, dispatcher Dispatcher) {
+	// the excess messages are dropped and rejected on behalf of the actor
+	if ctx, ok := invoker.(Context); ok {
+		m.actorSystem = ctx.ActorSystem()
+		m.self = ctx.Self()
+	}
+
+	m.Mailbox.RegisterHandlers(invoker, dispatcher)
+}
+
+func (m *rateLimitedMailbox) UserMessageCount() int {
+	m.mu.Lock()
+	pending := len(m.delayed) + len(m.ready)
+	m.mu.Unlock()
+
+	return m.Mailbox.UserMessageCount() + pending
+}
+
+func (m *rateLimitedMailbox) PostUserMessage(message interface{}) {
+	m.mu.Lock()
+
+	_, unlimited := UnwrapEnvelopeMessage(message).(AutoReceiveMessage)
+
+	switch {
+	case len(m.delayed) > 0:
+		// keeps the order of the messages, the delayed ones are posted first
+		m.delayed = append(m.delayed, message)
+		m.mu.Unlock()
+	case unlimited || m.bucket.take(time.Now()):
+		m.ready = append(m.ready, message)
+		m.mu.Unlock()
+		m.post()
+	case m.policy == RateLimitDelay:
+		m.delayed = append(m.delayed, message)
+		m.schedule()
+		m.mu.Unlock()
+	default:
+		retryAfter := m.bucket.wait(time.Now())
+		m.mu.Unlock()
+		m.reject(message, retryAfter)
+	}
+}
+
+// schedule posts the delayed messages once a token is available. The caller holds the lock.
+func (m *rateLimitedMailbox) schedule() {
+	if m.timer != nil {
+		return
+	}
+
+	m.timer = time.AfterFunc(m.bucket.wait(time.Now()), m.postDelayed)
+}
+
+func (m *rateLimitedMailbox) postDelayed() {
+	m.mu.Lock()
+
+	m.timer = nil
+	now := time.Now()
+
+	for len(m.delayed) > 0 {
+		_, unlimited := UnwrapEnvelopeMessage(m.delayed[0]).(AutoReceiveMessage)
+		if !unlimited && !m.bucket.take(now) {
+			break
+		}
+
+		m.ready = append(m.ready, m.delayed[0])
+		m.delayed[0] = nil
+		m.delayed = m.delayed[1:]
+	}
+
+	if len(m.delayed) > 0 {
+		m.schedule()
+	}
+
+	m.mu.Unlock()
+	m.post()
+}
+
+// post posts the ready messages to the inner mailbox without holding the lock, so that an actor processing them on
+// the posting goroutine, e.g. with a synchronized dispatcher, can post to itself. A single caller posts at a time,
+// the messages made ready meanwhile are posted by it in order.
+func (m *rateLimitedMailbox) post() {
+	m.mu.Lock()
+	if m.posting {
+		m.mu.Unlock()
+		return
+	}
+
+	m.posting = true
+
+	for len(m.ready) > 0 {
+		ready := m.ready
+		m.ready = nil
+		m.mu.Unlock()
+
+		for _, message := range ready {
+			m.Mailbox.PostUserMessage(message)
+		}
+
+		m.mu.Lock()
+	}
+
+	m.posting = false
+	m.mu.Unlock()
+}
+
+func (m *rateLimitedMailbox) reject(message interface{}, retryAfter time.Duration) {
+	if m.actorSystem == nil {
+		// not registered by an actor, there are no dead letters to forward the message to
+		m.mu.Lock()
+		m.ready = append(m.ready, message)
+		m.mu.Unlock()
+		m.post()
+
+		return
+	}
+
+	if sender := UnwrapEnvelopeSender(message); m.policy == RateLimitReject && sender != nil {
+		sender.sendUserMessage(m.actorSystem, &RateLimitedError{PID: m.self, RetryAfter: retryAfter})
+		return
+	}
+
+	m.actorSystem.DeadLetter.SendUserMessage(m.self, message)
+}
+
+// tokenBucket holds up to burst tokens, refilled at rate tokens per second
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// take takes a token, it returns false if there is none
+func (b *tokenBucket) take(now time.Time) bool {
+	b.refill(now)
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+// wait returns the time until the next token is available
+func (b *tokenBucket) wait(now time.Time) time.Duration {
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
@@ -0,0 +1,119 @@
+package actor
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimited_DelaysExcessMessagesInOrder(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []int
+	)
+
+	done := make(chan struct{})
+	props := PropsFromFunc(func(ctx Context) {
+		if i, ok := ctx.Message().(int); ok {
+			mu.Lock()
+			received = append(received, i)
+			mu.Unlock()
+			if i == 5 {
+				close(done)
+			}
+		}
+	}, WithMailbox(RateLimited(Unbounded(), 100, 2, RateLimitDelay)))
+
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	started := time.Now()
+	for i := 0; i < 6; i++ {
+		rootContext.Send(pid, i)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(testTimeout):
+		t.Fatal("delayed messages not processed")
+	}
+
+	// 2 messages in the burst, the other 4 at 100 per second
+	assert.GreaterOrEqual(t, time.Since(started), 35*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, received)
+}
+
+func TestRateLimited_DropsExcessMessages(t *testing.T) {
+	props := PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			ctx.Respond("ok")
+		}
+	}, WithMailbox(RateLimited(Unbounded(), 0.001, 1, RateLimitDrop)))
+
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	res, err := rootContext.RequestFuture(pid, "first", testTimeout).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	_, err = rootContext.RequestFuture(pid, "second", testTimeout).Result()
+	assert.ErrorIs(t, err, ErrDeadLetter)
+}
+
+func TestRateLimited_RejectsExcessRequests(t *testing.T) {
+	props := PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			ctx.Respond("ok")
+		}
+	}, WithMailbox(RateLimited(Unbounded(), 1, 1, RateLimitReject)))
+
+	pid := rootContext.Spawn(props)
+
+	_, err := rootContext.RequestFuture(pid, "first", testTimeout).Result()
+	require.NoError(t, err)
+
+	_, err = rootContext.RequestFuture(pid, "second", testTimeout).Result()
+	var rateLimited *RateLimitedError
+	require.ErrorAs(t, err, &rateLimited)
+	assert.Equal(t, pid, rateLimited.PID)
+	assert.Greater(t, rateLimited.RetryAfter, time.Duration(0))
+
+	// auto receive messages are not limited
+	assert.NoError(t, rootContext.PoisonFuture(pid).Wait())
+}
+
+func TestRateLimited_SelfSendWithSynchronizedDispatcher(t *testing.T) {
+	done := make(chan struct{})
+	props := PropsFromFunc(func(ctx Context) {
+		if i, ok := ctx.Message().(int); ok {
+			if i == 3 {
+				close(done)
+				return
+			}
+			ctx.Send(ctx.Self(), i+1)
+		}
+	}, WithMailbox(RateLimited(Unbounded(), 1000, 10, RateLimitDelay)), WithDispatcher(NewSynchronizedDispatcher(300)))
+
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	go rootContext.Send(pid, 0)
+
+	select {
+	case <-done:
+	case <-time.After(testTimeout):
+		t.Fatal("self sent messages not processed")
+	}
+}
+
+func TestRateLimited_PanicsOnNonPositiveRate(t *testing.T) {
+	assert.Panics(t, func() { RateLimited(Unbounded(), 0, 1, RateLimitDelay) })
+	assert.Panics(t, func() { RateLimited(Unbounded(), -1, 1, RateLimitDrop) })
+}
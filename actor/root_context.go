@@ -184,11 +184,22 @@ func (rc *RootContext) SpawnNamed(props *Props, name string) (*PID, error) {
 		rootContext = rc.Copy().WithGuardian(props.guardianStrategy)
 	}
 
+	var (
+		pid *PID
+		err error
+	)
+
 	if rootContext.spawnMiddleware != nil {
-		return rc.spawnMiddleware(rc.actorSystem, name, props, rootContext)
+		pid, err = rc.spawnMiddleware(rc.actorSystem, name, props, rootContext)
+	} else {
+		pid, err = props.spawn(rc.actorSystem, name, rootContext)
+	}
+
+	if err == nil && !props.systemActor {
+		rc.actorSystem.shutdown.trackUserActor(pid)
 	}
 
-	return props.spawn(rc.actorSystem, name, rootContext)
+	return pid, err
 }
 
 //
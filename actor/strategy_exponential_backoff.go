@@ -1,35 +1,123 @@
 package actor
 
 import (
+	"math"
 	"math/rand"
 	"time"
 )
 
+// BackoffMessagePolicy decides what happens to messages sent to a child while it waits for its delayed restart
+type BackoffMessagePolicy int32
+
+const (
+	// BackoffStashMessages keeps the messages in the suspended mailbox of the child until it has restarted
+	BackoffStashMessages BackoffMessagePolicy = iota
+	// BackoffDeadLetterMessages forwards the messages to dead letters, requests fail fast with ErrDeadLetter
+	BackoffDeadLetterMessages
+)
+
+// ExponentialBackoffOption configures the strategy created by NewExponentialBackoffStrategy
+type ExponentialBackoffOption func(strategy *exponentialBackoffStrategy)
+
+// WithBackoffMultiplier sets the factor the delay grows with on every consecutive failure, defaults to 2
+func WithBackoffMultiplier(multiplier float64) ExponentialBackoffOption {
+	return func(strategy *exponentialBackoffStrategy) {
+		strategy.multiplier = multiplier
+	}
+}
+
+// WithMaxBackoff caps the delay between restarts, zero means no cap
+func WithMaxBackoff(maxBackoff time.Duration) ExponentialBackoffOption {
+	return func(strategy *exponentialBackoffStrategy) {
+		strategy.maxBackoff = maxBackoff
+	}
+}
+
+// WithBackoffJitter randomizes each delay by up to the given fraction of it, e.g. 0.2 for +/- 20%
+func WithBackoffJitter(jitterFactor float64) ExponentialBackoffOption {
+	return func(strategy *exponentialBackoffStrategy) {
+		strategy.jitterFactor = jitterFactor
+	}
+}
+
+// WithBackoffMaxRetries applies the directive, StopDirective or EscalateDirective, once the child
+// failed more than maxRetries times within the backoff window
+func WithBackoffMaxRetries(maxRetries int, directive Directive) ExponentialBackoffOption {
+	return func(strategy *exponentialBackoffStrategy) {
+		strategy.maxRetries = maxRetries
+		strategy.maxRetriesDirective = directive
+	}
+}
+
+// WithBackoffMessagePolicy sets what happens to messages sent to the child during the backoff
+func WithBackoffMessagePolicy(policy BackoffMessagePolicy) ExponentialBackoffOption {
+	return func(strategy *exponentialBackoffStrategy) {
+		strategy.messagePolicy = policy
+	}
+}
+
 // NewExponentialBackoffStrategy creates a new Supervisor strategy that restarts a faulting child using an exponential
 // back off algorithm:
 //
-//	delay =
-func NewExponentialBackoffStrategy(backoffWindow time.Duration, initialBackoff time.Duration) SupervisorStrategy {
-	return &exponentialBackoffStrategy{
+//	delay = min(initialBackoff * multiplier^(failures-1), maxBackoff) +/- jitter
+//
+// The failure count is reset once the child has not failed for backoffWindow.
+func NewExponentialBackoffStrategy(backoffWindow time.Duration, initialBackoff time.Duration, opts ...ExponentialBackoffOption) SupervisorStrategy {
+	strategy := &exponentialBackoffStrategy{
 		backoffWindow:  backoffWindow,
 		initialBackoff: initialBackoff,
+		multiplier:     2,
+	}
+
+	for _, opt := range opts {
+		opt(strategy)
 	}
+
+	return strategy
 }
 
 type exponentialBackoffStrategy struct {
-	backoffWindow  time.Duration
-	initialBackoff time.Duration
+	backoffWindow       time.Duration
+	initialBackoff      time.Duration
+	multiplier          float64
+	maxBackoff          time.Duration
+	jitterFactor        float64
+	maxRetries          int
+	maxRetriesDirective Directive
+	messagePolicy       BackoffMessagePolicy
 }
 
 var _ SupervisorStrategy = &exponentialBackoffStrategy{}
 
-func (strategy *exponentialBackoffStrategy) HandleFailure(actorSystem *ActorSystem, supervisor Supervisor, child *PID, rs *RestartStatistics, reason interface{}, _ interface{}) {
+func (strategy *exponentialBackoffStrategy) HandleFailure(actorSystem *ActorSystem, supervisor Supervisor, child *PID, rs *RestartStatistics, reason interface{}, message interface{}) {
 	strategy.setFailureCount(rs)
 
-	backoff := rs.FailureCount() * int(strategy.initialBackoff.Nanoseconds())
-	noise := rand.Intn(500)
-	dur := time.Duration(backoff + noise)
-	time.AfterFunc(dur, func() {
+	if strategy.maxRetries > 0 && rs.FailureCount() > strategy.maxRetries {
+		rs.Reset()
+
+		if strategy.maxRetriesDirective == EscalateDirective {
+			supervisor.EscalateFailure(reason, message)
+
+			return
+		}
+
+		logFailure(actorSystem, child, reason, StopDirective)
+		supervisor.StopChildren(child)
+
+		return
+	}
+
+	if strategy.messagePolicy == BackoffDeadLetterMessages {
+		child.sendSystemMessage(actorSystem, backoffDeadLetterMessage)
+		supervisor.ResumeChildren(child)
+	}
+
+	time.AfterFunc(strategy.backoffDuration(rs.FailureCount()), func() {
+		// the child may have been stopped while backing off
+		if _, ok := actorSystem.ProcessRegistry.Get(child); !ok {
+			return
+		}
+
 		logFailure(actorSystem, child, reason, RestartDirective)
 		supervisor.RestartChildren(child)
 	})
@@ -42,3 +130,24 @@ func (strategy *exponentialBackoffStrategy) setFailureCount(rs *RestartStatistic
 
 	rs.Fail()
 }
+
+func (strategy *exponentialBackoffStrategy) backoffDuration(failureCount int) time.Duration {
+	backoff := float64(strategy.initialBackoff) * math.Pow(strategy.multiplier, float64(failureCount-1))
+	if strategy.maxBackoff > 0 && backoff > float64(strategy.maxBackoff) {
+		backoff = float64(strategy.maxBackoff)
+	}
+
+	if strategy.jitterFactor > 0 {
+		backoff += backoff * strategy.jitterFactor * (2*rand.Float64() - 1)
+	}
+
+	if backoff < 0 {
+		return 0
+	}
+
+	if backoff >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+
+	return time.Duration(backoff)
+}
@@ -54,3 +54,88 @@ func TestExponentialBackoffStrategy_ResetsFailureCount(t *testing.T) {
 
 	assert.Equal(t, 1, rs.FailureCount())
 }
+
+func TestExponentialBackoffStrategy_backoffDuration(t *testing.T) {
+	s := NewExponentialBackoffStrategy(10*time.Second, 100*time.Millisecond, WithMaxBackoff(time.Second)).(*exponentialBackoffStrategy)
+
+	assert.Equal(t, 100*time.Millisecond, s.backoffDuration(1))
+	assert.Equal(t, 200*time.Millisecond, s.backoffDuration(2))
+	assert.Equal(t, 800*time.Millisecond, s.backoffDuration(4))
+	assert.Equal(t, time.Second, s.backoffDuration(5))
+	assert.Equal(t, time.Second, s.backoffDuration(100))
+}
+
+func TestExponentialBackoffStrategy_backoffDurationWithJitter(t *testing.T) {
+	s := NewExponentialBackoffStrategy(10*time.Second, 100*time.Millisecond, WithBackoffJitter(0.5)).(*exponentialBackoffStrategy)
+
+	for i := 0; i < 100; i++ {
+		d := s.backoffDuration(1)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 150*time.Millisecond)
+	}
+}
+
+func TestExponentialBackoffStrategy_StopsAfterMaxRetries(t *testing.T) {
+	s := NewExponentialBackoffStrategy(10*time.Second, time.Millisecond, WithBackoffMaxRetries(2, StopDirective))
+	rs := NewRestartStatistics()
+	supervisor := new(mockSupervisor)
+	child := system.NewLocalPID("child")
+
+	supervisor.On("StopChildren", []*PID{child})
+
+	s.HandleFailure(system, supervisor, child, rs, "boom", nil)
+	s.HandleFailure(system, supervisor, child, rs, "boom", nil)
+	supervisor.AssertNotCalled(t, "StopChildren", []*PID{child})
+
+	s.HandleFailure(system, supervisor, child, rs, "boom", nil)
+	supervisor.AssertCalled(t, "StopChildren", []*PID{child})
+	assert.Equal(t, 0, rs.FailureCount())
+}
+
+func TestExponentialBackoffStrategy_DeadLettersMessagesDuringBackoff(t *testing.T) {
+	restarted := make(chan struct{}, 1)
+	child := PropsFromFunc(func(ctx Context) {
+		switch msg := ctx.Message().(type) {
+		case *Restarting:
+			restarted <- struct{}{}
+		case string:
+			if msg == "fail" {
+				panic("boom")
+			}
+			ctx.Respond(msg)
+		}
+	})
+
+	var childPid *PID
+	parent := PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(*Started); ok {
+			childPid = ctx.Spawn(child)
+		}
+		if _, ok := ctx.Message().(string); ok {
+			ctx.Respond(childPid)
+		}
+	}, WithSupervisor(NewExponentialBackoffStrategy(10*time.Second, 200*time.Millisecond, WithBackoffMessagePolicy(BackoffDeadLetterMessages))))
+
+	parentPid := rootContext.Spawn(parent)
+	defer rootContext.Stop(parentPid)
+
+	res, err := rootContext.RequestFuture(parentPid, "child", testTimeout).Result()
+	assert.NoError(t, err)
+	pid := res.(*PID)
+
+	rootContext.Send(pid, "fail")
+	time.Sleep(50 * time.Millisecond)
+
+	_, err = rootContext.RequestFuture(pid, "hello", testTimeout).Result()
+	assert.ErrorIs(t, err, ErrDeadLetter)
+
+	select {
+	case <-restarted:
+	case <-time.After(testTimeout):
+		assert.Fail(t, "child was not restarted")
+	}
+
+	res, err = rootContext.RequestFuture(pid, "hello", testTimeout).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", res)
+}
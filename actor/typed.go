@@ -0,0 +1,152 @@
+package actor
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// TypedActor is an actor receiving messages of a single, usually interface, type TMsg
+type TypedActor[TMsg any] interface {
+	Receive(ctx Context, message TMsg)
+}
+
+// UntypedReceiver can be implemented by a TypedActor to receive every message that is not a TMsg,
+// e.g. lifecycle messages like *Started and *Stopping, *Terminated or *ReceiveTimeout
+type UntypedReceiver interface {
+	ReceiveUntyped(ctx Context)
+}
+
+// TypedReceiveFunc is a function adapter for TypedActor
+type TypedReceiveFunc[TMsg any] func(ctx Context, message TMsg)
+
+// Receive calls f(ctx, message)
+func (f TypedReceiveFunc[TMsg]) Receive(ctx Context, message TMsg) {
+	f(ctx, message)
+}
+
+// TypedProps are Props of an actor only accepting messages of type TMsg
+type TypedProps[TMsg any] struct {
+	*Props
+}
+
+// PropsFromTypedProducer creates the props of a TypedActor
+func PropsFromTypedProducer[TMsg any](producer func() TypedActor[TMsg], opts ...PropsOption) *TypedProps[TMsg] {
+	return &TypedProps[TMsg]{
+		Props: PropsFromProducer(func() Actor {
+			return &typedActor[TMsg]{inner: producer()}
+		}, opts...),
+	}
+}
+
+// PropsFromTypedFunc creates the props of a TypedActor from a receive function
+func PropsFromTypedFunc[TMsg any](f TypedReceiveFunc[TMsg], opts ...PropsOption) *TypedProps[TMsg] {
+	return PropsFromTypedProducer(func() TypedActor[TMsg] { return f }, opts...)
+}
+
+type typedActor[TMsg any] struct {
+	inner TypedActor[TMsg]
+}
+
+func (a *typedActor[TMsg]) Receive(ctx Context) {
+	if msg, ok := ctx.Message().(TMsg); ok {
+		a.inner.Receive(ctx, msg)
+
+		return
+	}
+
+	if untyped, ok := a.inner.(UntypedReceiver); ok {
+		untyped.ReceiveUntyped(ctx)
+	}
+}
+
+// TypedPID is a PID of an actor accepting messages of type TMsg
+type TypedPID[TMsg any] struct {
+	pid *PID
+}
+
+// NewTypedPID wraps an existing PID, the caller guarantees the actor accepts messages of type TMsg
+func NewTypedPID[TMsg any](pid *PID) *TypedPID[TMsg] {
+	return &TypedPID[TMsg]{pid: pid}
+}
+
+// PID returns the untyped PID of the actor
+func (p *TypedPID[TMsg]) PID() *PID {
+	return p.pid
+}
+
+// Send sends a message to the actor
+func (p *TypedPID[TMsg]) Send(ctx SenderContext, message TMsg) {
+	ctx.Send(p.pid, message)
+}
+
+// Request sends a message to the actor with ctx.Self() as the sender
+func (p *TypedPID[TMsg]) Request(ctx SenderContext, message TMsg) {
+	ctx.Request(p.pid, message)
+}
+
+func (p *TypedPID[TMsg]) String() string {
+	return p.pid.String()
+}
+
+// Spawn starts a new typed actor named with a unique id
+func Spawn[TMsg any](ctx SpawnerContext, props *TypedProps[TMsg]) *TypedPID[TMsg] {
+	return NewTypedPID[TMsg](ctx.Spawn(props.Props))
+}
+
+// SpawnNamed starts a new typed actor named using the specified name
+//
+// ErrNameExists will be returned if id already exists
+func SpawnNamed[TMsg any](ctx SpawnerContext, props *TypedProps[TMsg], name string) (*TypedPID[TMsg], error) {
+	pid, err := ctx.SpawnNamed(props.Props, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTypedPID[TMsg](pid), nil
+}
+
+// ResponseTypeError is the error used when a typed request receives a response of another type
+type ResponseTypeError struct {
+	Expected string
+	Response interface{}
+}
+
+func (e *ResponseTypeError) Error() string {
+	return fmt.Sprintf("future: expected response of type %s, got %T", e.Expected, e.Response)
+}
+
+// TypedFuture is a Future resolving to a response of type TResp
+type TypedFuture[TResp any] struct {
+	*Future
+}
+
+// Result waits for the future to resolve, a response that is not a TResp results in a *ResponseTypeError
+func (f *TypedFuture[TResp]) Result() (TResp, error) {
+	var zero TResp
+
+	res, err := f.Future.Result()
+	if err != nil {
+		return zero, err
+	}
+
+	resp, ok := res.(TResp)
+	if !ok {
+		return zero, &ResponseTypeError{
+			Expected: reflect.TypeOf((*TResp)(nil)).Elem().String(),
+			Response: res,
+		}
+	}
+
+	return resp, nil
+}
+
+// RequestFuture sends a message to a typed actor and returns a future of the typed response
+func RequestFuture[TReq, TResp any](ctx SenderContext, pid *TypedPID[TReq], message TReq, timeout time.Duration) *TypedFuture[TResp] {
+	return &TypedFuture[TResp]{Future: ctx.RequestFuture(pid.pid, message, timeout)}
+}
+
+// Request sends a message to a typed actor and waits for a response of type TResp
+func Request[TReq, TResp any](ctx SenderContext, pid *TypedPID[TReq], message TReq, timeout time.Duration) (TResp, error) {
+	return RequestFuture[TReq, TResp](ctx, pid, message, timeout).Result()
+}
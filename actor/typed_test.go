@@ -0,0 +1,89 @@
+package actor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type greeterMessage interface {
+	greeterMessage()
+}
+
+type greet struct{ Name string }
+
+type countGreetings struct{}
+
+func (*greet) greeterMessage()          {}
+func (*countGreetings) greeterMessage() {}
+
+type greeter struct {
+	count   int
+	started bool
+}
+
+func (g *greeter) Receive(ctx Context, message greeterMessage) {
+	switch msg := message.(type) {
+	case *greet:
+		g.count++
+		ctx.Respond("hello " + msg.Name)
+	case *countGreetings:
+		ctx.Respond(g.count)
+	}
+}
+
+func (g *greeter) ReceiveUntyped(ctx Context) {
+	if _, ok := ctx.Message().(*Started); ok {
+		g.started = true
+	}
+}
+
+func TestTypedActor_Request(t *testing.T) {
+	props := PropsFromTypedProducer(func() TypedActor[greeterMessage] { return &greeter{} })
+	pid := Spawn(rootContext, props)
+	defer rootContext.Stop(pid.PID())
+
+	greeting, err := Request[greeterMessage, string](rootContext, pid, &greet{Name: "proto"}, testTimeout)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello proto", greeting)
+
+	count, err := Request[greeterMessage, int](rootContext, pid, &countGreetings{}, testTimeout)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestTypedActor_RequestWithUnexpectedResponseType(t *testing.T) {
+	props := PropsFromTypedProducer(func() TypedActor[greeterMessage] { return &greeter{} })
+	pid := Spawn(rootContext, props)
+	defer rootContext.Stop(pid.PID())
+
+	_, err := Request[greeterMessage, int](rootContext, pid, &greet{Name: "proto"}, testTimeout)
+
+	var typeErr *ResponseTypeError
+	assert.ErrorAs(t, err, &typeErr)
+	assert.Equal(t, "int", typeErr.Expected)
+	assert.Equal(t, "hello proto", typeErr.Response)
+}
+
+func TestTypedActor_InteroperatesWithUntypedActors(t *testing.T) {
+	untyped := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			ctx.Respond(len(msg))
+		}
+	}))
+	defer rootContext.Stop(untyped)
+
+	pid := NewTypedPID[string](untyped)
+	length, err := Request[string, int](rootContext, pid, "proto", testTimeout)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, length)
+
+	typed := Spawn(rootContext, PropsFromTypedFunc(func(ctx Context, msg string) {
+		ctx.Respond(msg + "!")
+	}))
+	defer rootContext.Stop(typed.PID())
+
+	res, err := rootContext.RequestFuture(typed.PID(), "hi", testTimeout).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "hi!", res)
+}
@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/remote"
+)
+
+// The reasons the cluster blocks members for
+const (
+	BlockReasonHeartbeatExpired = "heartbeat expired"
+	BlockReasonGracefullyLeft   = "gracefully left"
+	BlockReasonLeftTopology     = "left topology"
+	BlockReasonSplitBrain       = "downed by split brain resolver"
+)
+
+// Block blocks the members for the reason, so that they are removed from the topology and their connections
+// are refused. A ttl of 0 blocks them until they are unblocked. The block is gossiped to the other members.
+func (c *Cluster) Block(reason string, ttl time.Duration, memberIDs ...string) {
+	c.Remote.BlockList().BlockWithReason(reason, ttl, memberIDs...)
+	c.MemberList.refreshTopology()
+}
+
+// Unblock lets blocked members, e.g. falsely suspected ones, rejoin the cluster under their current ID. The unblock
+// is gossiped to the other members.
+func (c *Cluster) Unblock(memberIDs ...string) {
+	c.Logger().Info("Unblocking members", slog.String("members", strings.Join(memberIDs, ",")))
+	c.Remote.BlockList().Unblock(memberIDs...)
+	c.MemberList.refreshTopology()
+}
+
+// unblockExpired removes the blocks whose ttl passed, so that the members rejoin the topology. The expiry is gossiped
+// along with the BlockList.
+func (c *Cluster) unblockExpired() {
+	if expired := c.Remote.BlockList().RemoveExpired(); len(expired) > 0 {
+		c.Logger().Info("Block of members expired", slog.String("members", strings.Join(expired, ",")))
+		c.MemberList.refreshTopology()
+	}
+}
+
+// BlockedMemberEntries returns why and since when the members are blocked
+func (c *Cluster) BlockedMemberEntries() []remote.BlockEntry {
+	return c.Remote.BlockList().Entries()
+}
+
+// block is used by the cluster itself to block members, using the configured ttl
+func (c *Cluster) block(reason string, memberIDs ...string) {
+	c.Remote.BlockList().BlockWithReason(reason, c.Config.BlockTTL, memberIDs...)
+}
+
+func newBlockListState(blockList *remote.BlockList) *BlockListState {
+	state := &BlockListState{}
+	for _, entry := range blockList.Entries() {
+		state.Blocked = append(state.Blocked, &BlockedMember{
+			MemberId:                  entry.MemberID,
+			Reason:                    entry.Reason,
+			BlockedAtUnixMilliseconds: entry.BlockedAt.UnixMilli(),
+			TtlMilliseconds:           entry.TTL.Milliseconds(),
+		})
+	}
+
+	for id, t := range blockList.Unblocked() {
+		state.Unblocked = append(state.Unblocked, &UnblockedMember{
+			MemberId:                    id,
+			UnblockedAtUnixMilliseconds: t.UnixMilli(),
+		})
+	}
+
+	return state
+}
+
+// mergeInto merges the gossiped block list into the local one, it returns the IDs of the members it unblocked
+func (s *BlockListState) mergeInto(blockList *remote.BlockList) []string {
+	entries := make([]remote.BlockEntry, 0, len(s.Blocked))
+	for _, b := range s.Blocked {
+		entries = append(entries, remote.BlockEntry{
+			MemberID:  b.MemberId,
+			Reason:    b.Reason,
+			BlockedAt: time.UnixMilli(b.BlockedAtUnixMilliseconds),
+			TTL:       time.Duration(b.TtlMilliseconds) * time.Millisecond,
+		})
+	}
+
+	unblocked := make(map[string]time.Time, len(s.Unblocked))
+	for _, u := range s.Unblocked {
+		unblocked[u.MemberId] = time.UnixMilli(u.UnblockedAtUnixMilliseconds)
+	}
+
+	return blockList.Merge(entries, unblocked)
+}
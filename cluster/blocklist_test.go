@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestBlockListState_GossipsReasonsAndUnblocks(t *testing.T) {
+	local := remote.NewBlockList()
+	local.BlockWithReason(BlockReasonHeartbeatExpired, time.Minute, "a")
+
+	other := remote.NewBlockList()
+	other.Block("b")
+	time.Sleep(2 * time.Millisecond)
+
+	local.Unblock("b")
+	state := newBlockListState(local)
+
+	unblocked := state.mergeInto(other)
+	assert.Equal(t, []string{"b"}, unblocked)
+
+	entry, ok := other.Entry("a")
+	assert.True(t, ok)
+	assert.Equal(t, BlockReasonHeartbeatExpired, entry.Reason)
+	assert.Equal(t, time.Minute, entry.TTL)
+	assert.False(t, other.IsBlocked("b"))
+}
+
+func TestCluster_UnblockExpired(t *testing.T) {
+	c := newClusterForTest("test-UnblockExpired", nil)
+
+	members := newMembersForTest(2)
+	c.MemberList.UpdateClusterTopology(members)
+
+	c.Block(BlockReasonHeartbeatExpired, 20*time.Millisecond, members[1].Id)
+	assert.False(t, c.MemberList.ContainsMemberID(members[1].Id))
+
+	time.Sleep(30 * time.Millisecond)
+	c.unblockExpired()
+
+	// the member rejoins the topology as soon as its block expired
+	assert.True(t, c.MemberList.ContainsMemberID(members[1].Id))
+	assert.Empty(t, c.BlockedMemberEntries())
+}
+
+func TestCluster_StaleTopologyDoesNotBlockAgain(t *testing.T) {
+	c := newClusterForTest("test-StaleTopologyDoesNotBlockAgain", nil)
+
+	members := newMembersForTest(2)
+	c.MemberList.UpdateClusterTopology(members)
+
+	c.Block(BlockReasonHeartbeatExpired, time.Minute, members[1].Id)
+	c.Unblock(members[1].Id)
+
+	// a peer still gossips the topology it computed while the member was blocked
+	value, err := anypb.New(&ClusterTopology{Members: members, Blocked: []string{members[1].Id}})
+	require.NoError(t, err)
+	c.ActorSystem.EventStream.Publish(&GossipUpdate{MemberID: "peer", Key: TopologyKey, Value: value})
+
+	assert.False(t, c.Remote.BlockList().IsBlocked(members[1].Id))
+	assert.True(t, c.MemberList.ContainsMemberID(members[1].Id))
+}
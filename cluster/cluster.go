@@ -1,14 +1,22 @@
 package cluster
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/asynkron/gofun/set"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/eventstream"
 	"github.com/asynkron/protoactor-go/extensions"
 	"github.com/asynkron/protoactor-go/remote"
 )
@@ -25,7 +33,17 @@ type Cluster struct {
 	MemberList     *MemberList
 	IdentityLookup IdentityLookup
 	kinds          map[string]*ActivatedKind
+	kindsMutex     sync.RWMutex
 	context        Context
+	graceful       bool
+	isClient       bool
+	left           atomic.Bool // the cluster was left through Leave, the shutdown tasks have nothing left to do
+	splitBrain     *splitBrainResolver
+	leaderElection *leaderElection
+	singletons     *singletons
+	loads          *memberLoads
+	metrics        *clusterMetrics
+	topologySub    *eventstream.Subscription
 }
 
 var _ extensions.Extension = &Cluster{}
@@ -35,12 +53,16 @@ func New(actorSystem *actor.ActorSystem, config *Config) *Cluster {
 		ActorSystem: actorSystem,
 		Config:      config,
 		kinds:       map[string]*ActivatedKind{},
+		graceful:    true,
 	}
 	actorSystem.Extensions.Register(c)
 
 	c.context = config.ClusterContextProducer(c)
 	c.PidCache = NewPidCache()
 	c.MemberList = NewMemberList(c)
+	c.singletons = newSingletons(c)
+	c.loads = newMemberLoads(c)
+	c.metrics = newClusterMetrics(actorSystem)
 	c.subscribeToTopologyEvents()
 
 	actorSystem.Extensions.Register(c)
@@ -57,7 +79,7 @@ func New(actorSystem *actor.ActorSystem, config *Config) *Cluster {
 }
 
 func (c *Cluster) subscribeToTopologyEvents() {
-	c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+	c.topologySub = c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
 		if clusterTopology, ok := evt.(*ClusterTopology); ok {
 			for _, member := range clusterTopology.Left {
 				c.PidCache.RemoveByMember(member)
@@ -81,80 +103,333 @@ func (c *Cluster) GetBlockedMembers() set.Set[string] {
 	return c.Remote.BlockList().BlockedMembers()
 }
 
-func (c *Cluster) StartMember() {
-	cfg := c.Config
-	c.Remote = remote.NewRemote(c.ActorSystem, c.Config.RemoteConfig)
+// StartMember joins the cluster as a member hosting the kinds of the Config, see attachRemote for the transport. It
+// returns once this member is part of a topology all the members agree on and the identity lookup is ready, or with
+// an error when a component fails to start or the context is done first. On error the components started so far are
+// stopped again, the cluster cannot be started again but a new one can be created with New.
+func (c *Cluster) StartMember(ctx context.Context) (err error) {
+	var started rollback
+	defer func() {
+		if err != nil {
+			c.abortStart(started, err)
+		}
+	}()
 
+	cfg := c.Config
 	c.initKinds()
-
-	// TODO: make it possible to become a cluster even if remoting is already started
-	c.Remote.Start()
+	started.add(c.unsubscribe)
+	if c.attachRemote() {
+		started.add(func() { c.Remote.Shutdown(true) })
+	}
 
 	address := c.ActorSystem.Address()
 	c.Logger().Info("Starting Proto.Actor cluster member", slog.String("address", address))
 
 	c.IdentityLookup = cfg.IdentityLookup
 	c.IdentityLookup.Setup(c, c.GetClusterKinds(), false)
+	started.add(c.IdentityLookup.Shutdown)
 
-	// TODO: Disable Gossip for now until API changes are done
 	// gossiper must be started whenever any topology events starts flowing
 	if err := c.Gossip.StartGossiping(); err != nil {
-		panic(err)
+		return fmt.Errorf("cluster: failed to start gossip: %w", err)
 	}
-	c.PubSub.Start()
+	started.add(c.Gossip.Shutdown)
+
+	if err := c.PubSub.Start(); err != nil {
+		return fmt.Errorf("cluster: failed to start pubsub: %w", err)
+	}
+	started.add(func() { _ = c.PubSub.drain(context.Background()) })
+
 	c.MemberList.InitializeTopologyConsensus()
+	c.Gossip.SetState(StartedAtKey, timestamppb.Now())
+	c.gossipPlacement()
+
+	c.leaderElection = newLeaderElection(c)
+	c.leaderElection.start()
+	started.add(c.leaderElection.stop)
+
+	if cfg.SplitBrainStrategy != nil {
+		c.splitBrain = newSplitBrainResolver(c)
+		c.splitBrain.start()
+		started.add(c.splitBrain.stop)
+	}
 
 	if err := cfg.ClusterProvider.StartMember(c); err != nil {
-		panic(err)
+		return fmt.Errorf("cluster: failed to start cluster provider: %w", err)
 	}
+	started.add(func() { _ = cfg.ClusterProvider.Shutdown(true) })
 
-	time.Sleep(1 * time.Second)
+	shutdown := c.ActorSystem.CoordinatedShutdown()
+	shutdown.AddTask(actor.ShutdownPhaseLeaveCluster, "cluster-leave", 0, c.unlessLeft(c.leave))
+	shutdown.AddTask(actor.ShutdownPhaseDrainPubSub, "cluster-pubsub-drain", 0, c.unlessLeft(c.PubSub.drain))
+
+	if err := c.waitTopologyConsensus(ctx); err != nil {
+		return err
+	}
+
+	return c.waitIdentityLookup(ctx)
 }
 
+// GetClusterKinds returns the sorted names of the kinds hosted by the local member
 func (c *Cluster) GetClusterKinds() []string {
+	c.kindsMutex.RLock()
+	defer c.kindsMutex.RUnlock()
+
 	keys := make([]string, 0, len(c.kinds))
 	for k := range c.kinds {
 		keys = append(keys, k)
 	}
+	slices.Sort(keys)
 
 	return keys
 }
 
-func (c *Cluster) StartClient() {
-	cfg := c.Config
-	c.Remote = remote.NewRemote(c.ActorSystem, c.Config.RemoteConfig)
+// StartClient connects to the cluster as a client hosting no kinds, see attachRemote for the transport. It returns once
+// the identity lookup is ready, or with an error when a component fails to start or the context is done first. On error
+// the components started so far are stopped again, like with StartMember.
+func (c *Cluster) StartClient(ctx context.Context) (err error) {
+	var started rollback
+	defer func() {
+		if err != nil {
+			c.abortStart(started, err)
+		}
+	}()
 
-	c.Remote.Start()
+	cfg := c.Config
+	c.isClient = true
+	started.add(c.unsubscribe)
+	if c.attachRemote() {
+		started.add(func() { c.Remote.Shutdown(true) })
+	}
 
 	address := c.ActorSystem.Address()
 	c.Logger().Info("Starting Proto.Actor cluster-client", slog.String("address", address))
 
 	c.IdentityLookup = cfg.IdentityLookup
 	c.IdentityLookup.Setup(c, c.GetClusterKinds(), true)
+	started.add(c.IdentityLookup.Shutdown)
 
 	if err := cfg.ClusterProvider.StartClient(c); err != nil {
-		panic(err)
+		return fmt.Errorf("cluster: failed to start cluster provider: %w", err)
+	}
+	started.add(func() { _ = cfg.ClusterProvider.Shutdown(true) })
+
+	if err := c.PubSub.Start(); err != nil {
+		return fmt.Errorf("cluster: failed to start pubsub: %w", err)
+	}
+	started.add(func() { _ = c.PubSub.drain(context.Background()) })
+
+	shutdown := c.ActorSystem.CoordinatedShutdown()
+	shutdown.AddTask(actor.ShutdownPhaseLeaveCluster, "cluster-client-leave", 0, c.unlessLeft(c.leaveClient))
+	shutdown.AddTask(actor.ShutdownPhaseDrainPubSub, "cluster-pubsub-drain", 0, c.unlessLeft(c.PubSub.drain))
+
+	return c.waitIdentityLookup(ctx)
+}
+
+// rollback stops the components started so far, in reverse order, when starting the cluster failed
+type rollback []func()
+
+func (r *rollback) add(stop func()) {
+	*r = append(*r, stop)
+}
+
+// abortStart stops the started components, the shutdown tasks already registered have nothing left to do
+func (c *Cluster) abortStart(started rollback, err error) {
+	c.Logger().Error("Failed to start Proto.Actor cluster, stopping the started components", slog.Any("error", err))
+
+	c.left.Store(true)
+
+	for i := len(started) - 1; i >= 0; i-- {
+		started[i]()
 	}
-	c.PubSub.Start()
 }
 
-func (c *Cluster) Shutdown(graceful bool) {
-	c.Gossip.SetState(GracefullyLeftKey, &emptypb.Empty{})
-	c.ActorSystem.Shutdown()
-	if graceful {
-		_ = c.Config.ClusterProvider.Shutdown(graceful)
-		c.IdentityLookup.Shutdown()
-		// This is to wait ownership transferring complete.
-		time.Sleep(time.Millisecond * 2000)
-		c.MemberList.stopMemberList()
-		c.IdentityLookup.Shutdown()
-		c.Gossip.Shutdown()
+// attachRemote attaches the cluster to the Remote already started on the actor system, e.g. to serve point-to-point
+// traffic before joining the cluster, and ignores Config.RemoteConfig then. Otherwise a Remote configured with
+// Config.RemoteConfig is started. It returns true if it started the Remote.
+func (c *Cluster) attachRemote() bool {
+	if r, ok := remote.TryGetRemote(c.ActorSystem); ok && r.Started() {
+		c.Remote = r
+		c.Logger().Info("Attaching Proto.Actor cluster to the started remote", slog.String("address", c.ActorSystem.Address()))
+
+		return false
+	}
+
+	c.Remote = remote.NewRemote(c.ActorSystem, c.Config.RemoteConfig)
+	c.Remote.Start()
+
+	return true
+}
+
+// waitTopologyConsensus blocks until this member is part of the topology and all the members agree on it
+func (c *Cluster) waitTopologyConsensus(ctx context.Context) error {
+	err := waitUntil(ctx, func() bool {
+		if _, ok := c.MemberList.TopologyConsensus(ctx); !ok {
+			return false
+		}
+
+		return c.MemberList.ContainsMemberID(c.ActorSystem.ID)
+	})
+	if err != nil {
+		return fmt.Errorf("cluster: topology consensus not reached: %w", err)
+	}
+
+	return nil
+}
+
+// waitIdentityLookup blocks until the identity lookup is ready when it implements ReadyIdentityLookup
+func (c *Cluster) waitIdentityLookup(ctx context.Context) error {
+	lookup, ok := c.IdentityLookup.(ReadyIdentityLookup)
+	if !ok {
+		return nil
+	}
+
+	if err := lookup.WaitReady(ctx); err != nil {
+		return fmt.Errorf("cluster: identity lookup not ready: %w", err)
+	}
+
+	return nil
+}
+
+// readinessPollInterval is how often the readiness conditions without a signal of their own are checked
+const readinessPollInterval = 20 * time.Millisecond
+
+// waitUntil polls the condition until it holds or the context is done
+func waitUntil(ctx context.Context, condition func() bool) error {
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+
+	for !condition() {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 
-	c.Remote.Shutdown(graceful)
+	return nil
+}
+
+// Shutdown leaves the cluster and shuts the actor system down through its coordinated shutdown.
+// A non-graceful shutdown kills the remote transport first and skips the cluster leave protocol.
+// It returns the errors of the shutdown tasks, or the context error if the context is done before they complete.
+func (c *Cluster) Shutdown(ctx context.Context, graceful bool) error {
+	c.graceful = graceful
+	if !graceful {
+		if c.Gossip.pid != nil {
+			c.Gossip.SetState(GracefullyLeftKey, &emptypb.Empty{})
+		}
+		c.Remote.Shutdown(false)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.ActorSystem.Shutdown()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("cluster: shutdown failed: %w", err)
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 
 	address := c.ActorSystem.Address()
 	c.Logger().Info("Stopped Proto.Actor cluster", slog.String("address", address))
+
+	return nil
+}
+
+// Leave gracefully leaves the cluster and stops the cluster components, the activations and the singletons of this
+// member included, without shutting the actor system and its Remote down. Only the first call has any effect. The
+// cluster cannot be started again, the other members keep the ID of the actor system blocked as gracefully left.
+func (c *Cluster) Leave(ctx context.Context) error {
+	if !c.left.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	leave := c.leave
+	if c.isClient {
+		leave = c.leaveClient
+	}
+
+	if err := leave(ctx); err != nil {
+		return fmt.Errorf("cluster: failed to leave: %w", err)
+	}
+	if err := c.PubSub.drain(ctx); err != nil {
+		return fmt.Errorf("cluster: failed to drain pubsub: %w", err)
+	}
+
+	c.Logger().Info("Left Proto.Actor cluster", slog.String("address", c.ActorSystem.Address()))
+
+	return nil
+}
+
+// unlessLeft skips the shutdown task when the cluster was already left through Leave
+func (c *Cluster) unlessLeft(task actor.ShutdownTask) actor.ShutdownTask {
+	return func(ctx context.Context) error {
+		if c.left.Load() {
+			return nil
+		}
+
+		return task(ctx)
+	}
+}
+
+// leaveClient is the ShutdownPhaseLeaveCluster task of a cluster client
+func (c *Cluster) leaveClient(_ context.Context) error {
+	if !c.graceful {
+		return nil
+	}
+
+	c.singletons.stop()
+
+	err := c.Config.ClusterProvider.Shutdown(true)
+	c.IdentityLookup.Shutdown()
+	c.unsubscribe()
+
+	return err
+}
+
+// leave is the ShutdownPhaseLeaveCluster task of a cluster member
+func (c *Cluster) leave(_ context.Context) error {
+	if c.splitBrain != nil {
+		c.splitBrain.stop()
+	}
+
+	c.leaderElection.stop()
+
+	// the singletons are gossiped as stopped along with the leave, so that the next leader starts them
+	c.singletons.stop()
+
+	c.Gossip.SetState(GracefullyLeftKey, &emptypb.Empty{})
+	if !c.graceful {
+		c.unsubscribe()
+		return nil
+	}
+
+	// tell the other members we are leaving now rather than on the next gossip tick
+	c.Gossip.SendState()
+
+	_ = c.Config.ClusterProvider.Shutdown(true)
+
+	// stopping the identity lookup waits for the activations of this member to stop, so that they can be activated on
+	// the other members
+	c.IdentityLookup.Shutdown()
+
+	c.Gossip.Shutdown()
+	c.unsubscribe()
+
+	return nil
+}
+
+// unsubscribe removes the subscriptions of the cluster from the event stream of the actor system
+func (c *Cluster) unsubscribe() {
+	c.MemberList.stopMemberList()
+	c.ActorSystem.EventStream.Unsubscribe(c.topologySub)
+	c.loads.unsubscribe()
 }
 
 func (c *Cluster) Get(identity string, kind string) *actor.PID {
@@ -170,7 +445,7 @@ func (c *Cluster) RequestFuture(identity string, kind string, message interface{
 }
 
 func (c *Cluster) GetClusterKind(kind string) *ActivatedKind {
-	k, ok := c.kinds[kind]
+	k, ok := c.TryGetClusterKind(kind)
 	if !ok {
 		c.Logger().Error("Invalid kind", slog.String("kind", kind))
 
@@ -181,23 +456,39 @@ func (c *Cluster) GetClusterKind(kind string) *ActivatedKind {
 }
 
 func (c *Cluster) TryGetClusterKind(kind string) (*ActivatedKind, bool) {
+	c.kindsMutex.RLock()
+	defer c.kindsMutex.RUnlock()
+
 	k, ok := c.kinds[kind]
 
 	return k, ok
 }
 
+// activatedKinds returns a copy of the kinds hosted by the local member
+func (c *Cluster) activatedKinds() map[string]*ActivatedKind {
+	c.kindsMutex.RLock()
+	defer c.kindsMutex.RUnlock()
+
+	return maps.Clone(c.kinds)
+}
+
 func (c *Cluster) initKinds() {
+	kinds := make(map[string]*ActivatedKind, len(c.Config.Kinds)+1)
 	for name, kind := range c.Config.Kinds {
-		c.kinds[name] = kind.Build(c)
+		kinds[name] = kind.Build(c)
 	}
-	c.ensureTopicKindRegistered()
+	c.ensureTopicKindRegistered(kinds)
+
+	c.kindsMutex.Lock()
+	c.kinds = kinds
+	c.kindsMutex.Unlock()
 }
 
 // ensureTopicKindRegistered ensures that the topic kind is registered in the cluster
 // if topic kind is not registered, it will be registered automatically
-func (c *Cluster) ensureTopicKindRegistered() {
+func (c *Cluster) ensureTopicKindRegistered(kinds map[string]*ActivatedKind) {
 	hasTopicKind := false
-	for name := range c.kinds {
+	for name := range kinds {
 		if name == TopicActorKind {
 			hasTopicKind = true
 			break
@@ -206,7 +497,7 @@ func (c *Cluster) ensureTopicKindRegistered() {
 	if !hasTopicKind {
 		store := &EmptyKeyValueStore[*Subscribers]{}
 
-		c.kinds[TopicActorKind] = NewKind(TopicActorKind, actor.PropsFromProducer(func() actor.Actor {
+		kinds[TopicActorKind] = NewKind(TopicActorKind, actor.PropsFromProducer(func() actor.Actor {
 			return NewTopicActor(store, c.Logger())
 		})).Build(c)
 	}
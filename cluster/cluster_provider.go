@@ -10,3 +10,11 @@ type ClusterProvider interface {
 	Shutdown(graceful bool) error
 	// UpdateClusterState(state ClusterState) error
 }
+
+// KindsUpdatingProvider is implemented by the ClusterProviders able to advertise the kinds a started member
+// registers and unregisters at runtime, the other members learn them through gossip in any case
+type KindsUpdatingProvider interface {
+	ClusterProvider
+
+	UpdateKinds(kinds []string) error
+}
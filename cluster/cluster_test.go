@@ -2,7 +2,6 @@ package cluster
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -24,7 +23,6 @@ func newInmemoryProvider() *inmemoryProvider {
 }
 
 func (p *inmemoryProvider) init(c *Cluster) error {
-	name := c.Config.Name
 	host, port, err := c.ActorSystem.GetHostPort()
 	if err != nil {
 		return err
@@ -33,7 +31,7 @@ func (p *inmemoryProvider) init(c *Cluster) error {
 	p.self = &Member{
 		Host:  host,
 		Port:  int32(port),
-		Id:    fmt.Sprintf("%s@%s:%d", name, host, port),
+		Id:    c.ActorSystem.ID,
 		Kinds: c.GetClusterKinds(),
 	}
 
@@ -104,6 +102,17 @@ func (lu *fakeIdentityLookup) Setup(cluster *Cluster, kinds []string, isClient b
 func (lu *fakeIdentityLookup) Shutdown() {
 }
 
+// notReadyIdentityLookup never becomes ready
+type notReadyIdentityLookup struct {
+	fakeIdentityLookup
+}
+
+func (lu *notReadyIdentityLookup) WaitReady(ctx context.Context) error {
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
 func newClusterForTest(name string, cp ClusterProvider, opts ...ConfigOption) *Cluster {
 	system := actor.NewActorSystem()
 	lookup := fakeIdentityLookup{}
@@ -173,7 +182,7 @@ func TestCluster_Get(t *testing.T) {
 		}
 	}))
 	c := newClusterForTest("mycluster", cp, WithKinds(kind))
-	c.StartMember()
+	assert.NoError(t, c.StartMember(context.Background()))
 	cp.publishClusterTopologyEvent()
 	t.Run("invalid kind", func(t *testing.T) {
 		assert := assert.New(t)
@@ -188,3 +197,122 @@ func TestCluster_Get(t *testing.T) {
 		assert.NotNil(pid)
 	})
 }
+
+func TestCluster_StartMember(t *testing.T) {
+	system := actor.NewActorSystem()
+	c := New(system, Configure(t.Name(), newInmemoryProvider(), &fakeIdentityLookup{}, remote.Configure("127.0.0.1", 0)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	assert.NoError(t, c.StartMember(ctx))
+
+	// the member is part of the topology as soon as StartMember returns
+	assert.True(t, c.MemberList.ContainsMemberID(system.ID))
+	_, ok := c.MemberList.TopologyConsensus(ctx)
+	assert.True(t, ok)
+
+	assert.NoError(t, c.Shutdown(ctx, true))
+}
+
+func TestCluster_StartMember_IdentityLookupNotReady(t *testing.T) {
+	system := actor.NewActorSystem()
+	subscriptions := system.EventStream.Length()
+	c := New(system, Configure(t.Name(), newInmemoryProvider(), &notReadyIdentityLookup{}, remote.Configure("127.0.0.1", 0)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	err := c.StartMember(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "identity lookup not ready")
+
+	// the components started before the failure are stopped
+	assert.False(t, c.Remote.Started())
+	assert.Equal(t, subscriptions, system.EventStream.Length())
+	_, ok := system.ProcessRegistry.GetLocal(PubSubDeliveryName)
+	assert.False(t, ok)
+
+	assert.NoError(t, c.Shutdown(context.Background(), true))
+}
+
+func TestCluster_AttachRemote(t *testing.T) {
+	system := actor.NewActorSystem()
+	r := remote.NewRemote(system, remote.Configure("127.0.0.1", 0))
+	r.Start()
+	address := system.Address()
+	subscriptions := system.EventStream.Length()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c := New(system, Configure(t.Name(), newInmemoryProvider(), &fakeIdentityLookup{}, remote.Configure("127.0.0.1", 0)))
+	assert.NoError(t, c.StartMember(ctx))
+	assert.Same(t, r, c.Remote)
+	assert.Equal(t, address, system.Address())
+
+	_, err := c.RegisterSingleton("singleton", actor.PropsFromFunc(func(ctx actor.Context) {}))
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Leave(ctx))
+	assert.NoError(t, c.Leave(ctx))
+	assert.True(t, r.Started())
+
+	// the cluster leaves the actor system as it found it
+	assert.Equal(t, subscriptions, system.EventStream.Length())
+	_, ok := system.ProcessRegistry.GetLocal("singleton/singleton")
+	assert.False(t, ok)
+
+	assert.NoError(t, c.Shutdown(ctx, true))
+	assert.False(t, r.Started())
+}
+
+func TestCluster_RegisterKind(t *testing.T) {
+	system := actor.NewActorSystem()
+	c := New(system, Configure(t.Name(), newInmemoryProvider(), &fakeIdentityLookup{}, remote.Configure("127.0.0.1", 0)))
+	kind := NewKind("kind", actor.PropsFromFunc(func(ctx actor.Context) {}))
+
+	assert.ErrorIs(t, c.RegisterKind(kind), ErrMemberNotStarted)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	assert.NoError(t, c.StartMember(ctx))
+
+	assert.NoError(t, c.RegisterKind(kind))
+	assert.ErrorIs(t, c.RegisterKind(kind), ErrKindAlreadyRegistered)
+
+	assert.Contains(t, c.GetClusterKinds(), "kind")
+	assert.Contains(t, c.MemberList.Members().GetMemberById(system.ID).Kinds, "kind")
+	assert.Equal(t, c.ActorSystem.Address(), c.MemberList.getPartitionMemberV2(&ClusterIdentity{Kind: "kind", Identity: "identity"}))
+
+	assert.NoError(t, c.Shutdown(ctx, true))
+}
+
+func TestCluster_UnregisterKind(t *testing.T) {
+	system := actor.NewActorSystem()
+	props := actor.PropsFromFunc(func(ctx actor.Context) {})
+	c := New(system, Configure(t.Name(), newInmemoryProvider(), &fakeIdentityLookup{}, remote.Configure("127.0.0.1", 0), WithKinds(NewKind("kind", props))))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	assert.NoError(t, c.StartMember(ctx))
+
+	pid := system.Root.Spawn(props)
+	c.GetClusterKind("kind").activated(pid)
+
+	assert.NoError(t, c.UnregisterKind(ctx, "kind"))
+	assert.ErrorIs(t, c.UnregisterKind(ctx, "kind"), ErrKindNotRegistered)
+	assert.Error(t, c.UnregisterKind(ctx, TopicActorKind))
+
+	// the activations of the kind are stopped
+	_, err := system.Root.RequestFuture(pid, &actor.Touch{}, time.Second).Result()
+	assert.ErrorIs(t, err, actor.ErrDeadLetter)
+
+	assert.NotContains(t, c.GetClusterKinds(), "kind")
+	assert.NotContains(t, c.MemberList.Members().GetMemberById(system.ID).Kinds, "kind")
+	assert.Empty(t, c.MemberList.getPartitionMemberV2(&ClusterIdentity{Kind: "kind", Identity: "identity"}))
+
+	assert.NoError(t, c.Shutdown(ctx, true))
+}
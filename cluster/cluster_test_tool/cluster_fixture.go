@@ -123,7 +123,9 @@ func (b *BaseClusterFixture) RemoveNode(node *cluster.Cluster, graceful bool) {
 		if member == node {
 			has = true
 			b.members = append(b.members[:i], b.members[i+1:]...)
-			member.Shutdown(graceful)
+			if err := member.Shutdown(context.Background(), graceful); err != nil {
+				slog.Default().Error("failed to shutdown node", slog.Any("node", node), slog.Any("error", err))
+			}
 			break
 		}
 	}
@@ -185,7 +187,12 @@ func (b *BaseClusterFixture) spawnClusterMember() *cluster.Cluster {
 	system := actor.NewActorSystem()
 
 	c := cluster.New(system, config)
-	c.StartMember()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	if err := c.StartMember(ctx); err != nil {
+		panic(err)
+	}
 	return c
 }
 
@@ -205,7 +212,9 @@ func (b *BaseClusterFixture) waitForMembersToShutdown() {
 			done := make(chan struct{})
 			go func() {
 				slog.Default().Info("Shutting down cluster member", slog.String("member", member.ActorSystem.ID))
-				member.Shutdown(true)
+				if err := member.Shutdown(timeoutCtx, true); err != nil {
+					slog.Default().Error("Failed to shutdown cluster member", slog.String("member", member.ActorSystem.ID), slog.Any("error", err))
+				}
 				close(done)
 			}()
 
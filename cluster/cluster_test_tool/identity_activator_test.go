@@ -0,0 +1,64 @@
+package cluster_test_tool
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// firstMemberActivator activates all the identities on the member with the lowest address
+type firstMemberActivator struct {
+	cluster.MemberStrategy
+}
+
+func (s *firstMemberActivator) GetIdentityActivator(_ string) string {
+	addresses := make([]string, 0)
+	for _, member := range s.GetAllMembers() {
+		addresses = append(addresses, member.Address())
+	}
+
+	return slices.Min(addresses)
+}
+
+func TestDistHash_ActivatesOnIdentityActivator(t *testing.T) {
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(*wrapperspb.StringValue); ok {
+			ctx.Respond(wrapperspb.String(ctx.Self().String()))
+		}
+	})
+
+	fixture := NewBaseInMemoryClusterFixture(2, WithGetClusterKinds(func() []*cluster.Kind {
+		kind := cluster.NewKind("grain", props)
+		kind.WithMemberStrategy(func(c *cluster.Cluster) cluster.MemberStrategy {
+			return &firstMemberActivator{cluster.NewLoadAwareMemberStrategy()(c, "grain")}
+		})
+
+		return []*cluster.Kind{kind}
+	}))
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	activator := slices.Min([]string{members[0].ActorSystem.Address(), members[1].ActorSystem.Address()})
+
+	for i := 0; i < 20; i++ {
+		identity := fmt.Sprintf("identity-%d", i)
+
+		// the owner of the partition places the identity, the members get the same activation whoever owns it
+		var activations []string
+		for _, member := range members {
+			res, err := member.Request(identity, "grain", wrapperspb.String("ping"))
+			require.NoError(t, err)
+			activations = append(activations, res.(*wrapperspb.StringValue).Value)
+		}
+
+		assert.Equal(t, activations[0], activations[1])
+		assert.Contains(t, activations[0], activator)
+	}
+}
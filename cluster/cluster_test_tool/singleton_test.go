@@ -0,0 +1,90 @@
+package cluster_test_tool
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func agreedLeader(members []*cluster.Cluster) *cluster.Member {
+	var leader *cluster.Member
+	for _, member := range members {
+		l := member.Leader()
+		if l == nil || (leader != nil && leader.Id != l.Id) {
+			return nil
+		}
+		leader = l
+	}
+
+	return leader
+}
+
+func TestClusterSingleton_HandsOverWhenTheLeaderLeaves(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(3)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	var running int32
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		switch ctx.Message().(type) {
+		case *actor.Started:
+			atomic.AddInt32(&running, 1)
+		case *actor.Stopped:
+			atomic.AddInt32(&running, -1)
+		case *wrapperspb.StringValue:
+			ctx.Respond(wrapperspb.String(ctx.ActorSystem().ID))
+		}
+	})
+
+	var leader *cluster.Member
+	WaitUntil(t, func() bool {
+		leader = agreedLeader(fixture.GetMembers())
+		return leader != nil
+	}, "members should agree on a leader", 10*time.Second)
+	require.NotNil(t, leader)
+
+	singletons := map[*cluster.Cluster]*cluster.ClusterSingleton{}
+	for _, member := range fixture.GetMembers() {
+		singleton, err := member.RegisterSingleton("counter", props, cluster.WithSingletonHandoverTimeout(2*time.Second))
+		require.NoError(t, err)
+		singletons[member] = singleton
+	}
+
+	_, err := fixture.GetMembers()[0].RegisterSingleton("counter", props)
+	assert.ErrorIs(t, err, cluster.ErrSingletonAlreadyRegistered)
+
+	askAll := func(expected string) {
+		for _, member := range fixture.GetMembers() {
+			res, err := member.ActorSystem.Root.RequestFuture(singletons[member].PID(), wrapperspb.String("where"), 10*time.Second).Result()
+			require.NoError(t, err)
+			assert.Equal(t, expected, res.(*wrapperspb.StringValue).Value)
+		}
+	}
+
+	askAll(leader.Id)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&running))
+
+	for _, member := range fixture.GetMembers() {
+		if member.ActorSystem.ID == leader.Id {
+			fixture.RemoveNode(member, true)
+			delete(singletons, member)
+			break
+		}
+	}
+
+	var newLeader *cluster.Member
+	WaitUntil(t, func() bool {
+		newLeader = agreedLeader(fixture.GetMembers())
+		return newLeader != nil && newLeader.Id != leader.Id
+	}, "members should agree on a new leader", 10*time.Second)
+	require.NotNil(t, newLeader)
+
+	askAll(newLeader.Id)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&running))
+}
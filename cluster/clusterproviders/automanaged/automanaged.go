@@ -24,6 +24,7 @@ var (
 	deregisteredMutex          = new(sync.Mutex)
 	activeProviderMutex        = new(sync.Mutex)
 	activeProviderRunningMutex = new(sync.Mutex)
+	knownKindsMutex            = new(sync.Mutex)
 )
 
 type AutoManagedProvider struct {
@@ -129,6 +130,16 @@ func (p *AutoManagedProvider) StartClient(cluster *cluster.Cluster) error {
 	return nil
 }
 
+// UpdateKinds sets the kinds the member registered or unregistered at runtime, they are served to the other members
+// on their next status check
+func (p *AutoManagedProvider) UpdateKinds(kinds []string) error {
+	knownKindsMutex.Lock()
+	defer knownKindsMutex.Unlock()
+
+	p.knownKinds = kinds
+	return nil
+}
+
 // DeregisterMember set the shutdown to true preventing anymore TTL updates
 func (p *AutoManagedProvider) DeregisterMember() error {
 	deregisteredMutex.Lock()
@@ -246,10 +257,11 @@ func (p *AutoManagedProvider) monitorStatuses() {
 			continue
 		}
 		ms := &cluster.Member{
-			Id:    node.ID,
-			Host:  node.Address,
-			Port:  int32(node.Port),
-			Kinds: node.Kinds,
+			Id:     node.ID,
+			Host:   node.Address,
+			Port:   int32(node.Port),
+			Kinds:  node.Kinds,
+			Labels: node.Labels,
 		}
 		members = append(members, ms)
 		newNodes = append(newNodes, node)
@@ -380,5 +392,12 @@ func (p *AutoManagedProvider) isActiveProviderRunning() bool {
 }
 
 func (p *AutoManagedProvider) getCurrentNode() *NodeModel {
-	return NewNode(p.clusterName, p.cluster.ActorSystem.ID, p.address, p.memberPort, p.autoManagePort, p.knownKinds)
+	knownKindsMutex.Lock()
+	kinds := p.knownKinds
+	knownKindsMutex.Unlock()
+
+	node := NewNode(p.clusterName, p.cluster.ActorSystem.ID, p.address, p.memberPort, p.autoManagePort, kinds)
+	node.Labels = p.cluster.Config.Labels
+
+	return node
 }
@@ -1,6 +1,7 @@
 package automanaged
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -14,7 +15,7 @@ import (
 
 func TestMemberList_Broadcast(t *testing.T) {
 	c := startNode()
-	defer c.Shutdown(true)
+	defer c.Shutdown(context.Background(), true)
 
 	var receivedEvent *cluster.GrainRequest
 
@@ -38,7 +39,9 @@ func startNode() *cluster.Cluster {
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, config)
 	cluster := cluster.New(system, clusterConfig)
 
-	cluster.StartMember()
+	if err := cluster.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	return cluster
 }
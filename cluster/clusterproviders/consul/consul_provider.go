@@ -3,6 +3,7 @@ package consul
 import (
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +15,9 @@ import (
 
 var ProviderShuttingDownError = fmt.Errorf("consul cluster provider is shutting down")
 
+// metaLabelPrefix prefixes the service meta keys the labels of the member are registered with
+const metaLabelPrefix = "label-"
+
 type Provider struct {
 	cluster            *cluster.Cluster
 	deregistered       bool
@@ -85,7 +89,7 @@ func (p *Provider) StartMember(c *cluster.Cluster) error {
 
 	p.pid, err = c.ActorSystem.Root.SpawnNamed(actor.PropsFromProducer(func() actor.Actor {
 		return newProviderActor(p)
-	}), "consul-provider")
+	}, actor.WithSystemActor()), "consul-provider")
 	if err != nil {
 		p.cluster.Logger().Error("Failed to start consul-provider actor", slog.Any("error", err))
 		return err
@@ -103,6 +107,16 @@ func (p *Provider) StartClient(c *cluster.Cluster) error {
 	return nil
 }
 
+// UpdateKinds registers the service again with the kinds the member registered or unregistered at runtime
+func (p *Provider) UpdateKinds(kinds []string) error {
+	if p.pid == nil {
+		return nil
+	}
+
+	p.cluster.ActorSystem.Root.Send(p.pid, &UpdateKinds{kinds: kinds})
+	return nil
+}
+
 func (p *Provider) DeregisterMember() error {
 	err := p.deregisterService()
 	if err != nil {
@@ -140,9 +154,7 @@ func (p *Provider) registerService() error {
 		Tags:    p.knownKinds,
 		Address: p.address,
 		Port:    p.port,
-		Meta: map[string]string{
-			"id": p.id,
-		},
+		Meta:    labelsToMeta(p.cluster.Config.Labels, map[string]string{"id": p.id}),
 		Check: &api.AgentServiceCheck{
 			DeregisterCriticalServiceAfter: p.deregisterCritical.String(),
 			TTL:                            p.ttl.String(),
@@ -182,10 +194,11 @@ func (p *Provider) notifyStatuses() {
 				p.cluster.Logger().Info("meta['id'] was empty, fixeds", slog.String("id", memberId))
 			}
 			members = append(members, &cluster.Member{
-				Id:    memberId,
-				Host:  v.Service.Address,
-				Port:  int32(v.Service.Port),
-				Kinds: v.Service.Tags,
+				Id:     memberId,
+				Host:   v.Service.Address,
+				Port:   int32(v.Service.Port),
+				Kinds:  v.Service.Tags,
+				Labels: labelsFromMeta(v.Service.Meta),
 			})
 		}
 	}
@@ -204,3 +217,28 @@ func (p *Provider) monitorMemberStatusChanges() {
 		}
 	}()
 }
+
+// labelsToMeta adds the labels to the service meta, consul only accepts keys made of letters, digits, '-' and '_'
+func labelsToMeta(labels map[string]string, meta map[string]string) map[string]string {
+	for key, value := range labels {
+		meta[metaLabelPrefix+key] = value
+	}
+
+	return meta
+}
+
+func labelsFromMeta(meta map[string]string) map[string]string {
+	var labels map[string]string
+
+	for key, value := range meta {
+		if label, ok := strings.CutPrefix(key, metaLabelPrefix); ok {
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+
+			labels[label] = value
+		}
+	}
+
+	return labels
+}
@@ -20,6 +20,7 @@ type providerActor struct {
 type (
 	RegisterService   struct{}
 	UpdateTTL         struct{}
+	UpdateKinds       struct{ kinds []string }
 	MemberListUpdated struct {
 		members []*cluster.Member
 		index   uint64
@@ -40,9 +41,12 @@ func newProviderActor(provider *Provider) actor.Actor {
 }
 
 func (pa *providerActor) init(ctx actor.Context) {
-	switch ctx.Message().(type) {
+	switch msg := ctx.Message().(type) {
 	case *actor.Started:
 		ctx.Send(ctx.Self(), &RegisterService{})
+	case *UpdateKinds:
+		// the pending registration uses them
+		pa.knownKinds = msg.kinds
 	case *RegisterService:
 		if err := pa.registerService(); err != nil {
 			ctx.Logger().Error("Failed to register service to consul, will retry", slog.Any("error", err))
@@ -64,6 +68,11 @@ func (pa *providerActor) running(ctx actor.Context) {
 		if err := blockingUpdateTTL(pa.Provider); err != nil {
 			ctx.Logger().Warn("Failed to update TTL", slog.Any("error", err))
 		}
+	case *UpdateKinds:
+		pa.knownKinds = msg.kinds
+		if err := pa.registerService(); err != nil {
+			ctx.Logger().Error("Failed to update the kinds of the service in consul", slog.Any("error", err))
+		}
 	case *MemberListUpdated:
 		pa.cluster.MemberList.UpdateClusterTopology(msg.members)
 	case *actor.Stopping:
@@ -115,10 +124,11 @@ func (pa *providerActor) processConsulUpdate(index uint64, result interface{}, c
 				ctx.Logger().Info("meta['id'] was empty, fixed", slog.String("id", memberId))
 			}
 			members = append(members, &cluster.Member{
-				Id:    memberId,
-				Host:  v.Service.Address,
-				Port:  int32(v.Service.Port),
-				Kinds: v.Service.Tags,
+				Id:     memberId,
+				Host:   v.Service.Address,
+				Port:   int32(v.Service.Port),
+				Kinds:  v.Service.Tags,
+				Labels: labelsFromMeta(v.Service.Meta),
 			})
 		}
 	}
@@ -71,6 +71,7 @@ func (p *Provider) init(c *cluster.Cluster) error {
 	nodeName := fmt.Sprintf("%v@%v", p.clusterName, memberID)
 	p.self = NewNode(nodeName, host, port, knownKinds)
 	p.self.SetMeta("id", p.getID())
+	p.self.Labels = c.Config.Labels
 	return nil
 }
 
@@ -130,6 +131,16 @@ func (p *Provider) Shutdown(graceful bool) error {
 	return nil
 }
 
+// UpdateKinds registers the member again with the kinds it registered or unregistered at runtime
+func (p *Provider) UpdateKinds(kinds []string) error {
+	if p.self == nil || p.deregistered {
+		return nil
+	}
+
+	p.self.Kinds = kinds
+	return p.registerService()
+}
+
 func (p *Provider) keepAliveForever(ctx context.Context) error {
 	if p.self == nil {
 		return fmt.Errorf("keepalive must be after initialize")
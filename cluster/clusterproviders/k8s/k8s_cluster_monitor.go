@@ -29,6 +29,15 @@ func (kcm *k8sClusterMonitorActor) init(ctx actor.Context) {
 			return
 		}
 		ctx.Logger().Info("Registered service to k8s")
+	case *UpdateKinds:
+		kcm.knownKinds = r.Kinds
+		timeout := getTimeout(ctx, kcm)
+
+		if err := kcm.updateKinds(timeout); err != nil {
+			ctx.Logger().Error("Failed to update the kinds of the service in k8s", slog.Any("error", err))
+			return
+		}
+		ctx.Logger().Info("Updated the kinds of the service in k8s")
 	case *DeregisterMember:
 		ctx.Logger().Debug("Deregistering service from k8s")
 		timeout := getTimeout(ctx, kcm)
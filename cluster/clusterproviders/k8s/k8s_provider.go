@@ -158,7 +158,7 @@ func (p *Provider) startClusterMonitor(c *cluster.Cluster) error {
 	var err error
 	p.clusterMonitor, err = c.ActorSystem.Root.SpawnNamed(actor.PropsFromProducer(func() actor.Actor {
 		return newClusterMonitor(p)
-	}), "k8s-cluster-monitor")
+	}, actor.WithSystemActor()), "k8s-cluster-monitor")
 	if err != nil {
 		p.cluster.Logger().Error("Failed to start k8s-cluster-monitor actor", slog.Any("error", err))
 		return err
@@ -200,6 +200,11 @@ func (p *Provider) registerMember(timeout time.Duration) error {
 		labels[labelkey] = "true"
 	}
 
+	// add the labels of the member
+	for key, value := range p.cluster.Config.Labels {
+		labels[fmt.Sprintf("%s-%s", LabelMember, key)] = value
+	}
+
 	// add existing labels back
 	for key, value := range pod.ObjectMeta.Labels {
 		labels[key] = value
@@ -318,10 +323,18 @@ func mapPodsToMembers(clusterPods map[types.UID]*v1.Pod, logger *slog.Logger) []
 		if clusterPod.Status.Phase == "Running" && len(clusterPod.Status.PodIPs) > 0 {
 
 			var kinds []string
+			var labels map[string]string
 			for key, value := range clusterPod.ObjectMeta.Labels {
 				if strings.HasPrefix(key, LabelKind) && value == "true" {
 					kinds = append(kinds, strings.Replace(key, fmt.Sprintf("%s-", LabelKind), "", 1))
 				}
+
+				if label, ok := strings.CutPrefix(key, fmt.Sprintf("%s-", LabelMember)); ok {
+					if labels == nil {
+						labels = make(map[string]string)
+					}
+					labels[label] = value
+				}
 			}
 
 			host := clusterPod.Status.PodIP
@@ -349,10 +362,11 @@ func mapPodsToMembers(clusterPods map[types.UID]*v1.Pod, logger *slog.Logger) []
 			logger.Debug("Pod is running and all containers are ready", slog.String("podName", clusterPod.ObjectMeta.Name), slog.Any("podIPs", clusterPod.Status.PodIPs), slog.String("podPhase", string(clusterPod.Status.Phase)))
 
 			members = append(members, &cluster.Member{
-				Id:    mid,
-				Host:  host,
-				Port:  int32(port),
-				Kinds: kinds,
+				Id:     mid,
+				Host:   host,
+				Port:   int32(port),
+				Kinds:  kinds,
+				Labels: labels,
 			})
 		} else {
 			logger.Debug("Pod is not in Running state", slog.String("podName", clusterPod.ObjectMeta.Name), slog.Any("podIPs", clusterPod.Status.PodIPs), slog.String("podPhase", string(clusterPod.Status.Phase)))
@@ -362,6 +376,46 @@ func mapPodsToMembers(clusterPods map[types.UID]*v1.Pod, logger *slog.Logger) []
 	return members
 }
 
+// UpdateKinds replaces the kind labels of the pod with the kinds the member registered or unregistered at runtime
+func (p *Provider) UpdateKinds(kinds []string) error {
+	if p.clusterMonitor == nil {
+		return nil
+	}
+
+	p.cluster.ActorSystem.Root.Send(p.clusterMonitor, &UpdateKinds{Kinds: kinds})
+	return nil
+}
+
+// replaces the kind labels of its pod with the known kinds
+func (p *Provider) updateKinds(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	pod, err := p.client.CoreV1().Pods(p.retrieveNamespace()).Get(ctx, p.podName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to get own pod information for %s: %w", p.podName, err)
+	}
+
+	labels := pod.GetLabels()
+	if labels == nil {
+		labels = Labels{}
+	}
+
+	for labelKey := range labels {
+		if strings.HasPrefix(labelKey, LabelKind+"-") {
+			delete(labels, labelKey)
+		}
+	}
+
+	for _, kind := range p.knownKinds {
+		labels[fmt.Sprintf("%s-%s", LabelKind, kind)] = "true"
+	}
+
+	pod.SetLabels(labels)
+
+	return p.replacePodLabels(ctx, pod)
+}
+
 // deregister itself as a member from a k8s cluster
 func (p *Provider) deregisterMember(timeout time.Duration) error {
 	p.cluster.Logger().Info("Deregistering service from Kubernetes", slog.String("podName", p.podName), slog.String("address", p.address))
@@ -8,4 +8,5 @@ const (
 	LabelCluster     = LabelPrefix + "cluster"
 	LabelStatusValue = LabelPrefix + "status-value"
 	LabelMemberID    = LabelPrefix + "member-id"
+	LabelMember      = LabelPrefix + "label" // prefixes the labels of the member, e.g. cluster.proto.actor/label-zone
 )
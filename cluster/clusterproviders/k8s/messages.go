@@ -3,6 +3,11 @@ package k8s
 // RegisterMember message used to register a new member in k8s
 type RegisterMember struct{}
 
+// UpdateKinds message used to update the kinds of the member in k8s
+type UpdateKinds struct {
+	Kinds []string
+}
+
 // DeregisterMember Empty struct used to deregister a member from k8s
 type DeregisterMember struct{}
 
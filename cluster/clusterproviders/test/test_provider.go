@@ -41,6 +41,8 @@ func WithTestProviderDeregisterCritical(deregisterCritical time.Duration) Provid
 	}
 }
 
+var _ cluster.KindsUpdatingProvider = &Provider{}
+
 type Provider struct {
 	memberList *cluster.MemberList
 	config     *ProviderConfig
@@ -79,7 +81,9 @@ func (t *Provider) StartMember(c *cluster.Cluster) error {
 	t.id = c.ActorSystem.ID
 	t.startTtlReport()
 	t.agent.SubscribeStatusUpdate(t.notifyStatuses)
-	t.agent.RegisterService(NewAgentServiceStatus(t.id, host, port, kinds))
+	status := NewAgentServiceStatus(t.id, host, port, kinds)
+	status.Labels = c.Config.Labels
+	t.agent.RegisterService(status)
 	return nil
 }
 
@@ -100,6 +104,12 @@ func (t *Provider) Shutdown(_ bool) error {
 	return nil
 }
 
+// UpdateKinds advertises the kinds the member registered or unregistered at runtime.
+func (t *Provider) UpdateKinds(kinds []string) error {
+	t.agent.UpdateServiceKinds(t.id, kinds)
+	return nil
+}
+
 // notifyStatuses notifies the cluster that the service status has changed.
 func (t *Provider) notifyStatuses() {
 	statuses := t.agent.GetStatusHealth()
@@ -111,10 +121,11 @@ func (t *Provider) notifyStatuses() {
 		copiedKinds = append(copiedKinds, status.Kinds...)
 
 		members = append(members, &cluster.Member{
-			Id:    status.ID,
-			Port:  int32(status.Port),
-			Host:  status.Host,
-			Kinds: copiedKinds,
+			Id:     status.ID,
+			Port:   int32(status.Port),
+			Host:   status.Host,
+			Kinds:  copiedKinds,
+			Labels: maps.Clone(status.Labels),
 		})
 	}
 	t.memberList.UpdateClusterTopology(members)
@@ -175,6 +186,21 @@ func (m *InMemAgent) RefreshServiceTTL(id string) {
 	}
 }
 
+// UpdateServiceKinds updates the kinds of a service.
+func (m *InMemAgent) UpdateServiceKinds(id string, kinds []string) {
+	m.servicesLock.Lock()
+	service, ok := m.services[id]
+	if ok {
+		service.Kinds = kinds
+		m.services[id] = service
+	}
+	m.servicesLock.Unlock()
+
+	if ok {
+		m.onStatusUpdate()
+	}
+}
+
 // SubscribeStatusUpdate registers a handler that will be called when the service map changes.
 func (m *InMemAgent) SubscribeStatusUpdate(handler func()) {
 	m.statusUpdateHandlersLock.Lock()
@@ -203,11 +229,12 @@ func (m *InMemAgent) onStatusUpdate() {
 }
 
 type AgentServiceStatus struct {
-	ID    string
-	TTL   time.Time // last alive time
-	Host  string
-	Port  int
-	Kinds []string
+	ID     string
+	TTL    time.Time // last alive time
+	Host   string
+	Port   int
+	Kinds  []string
+	Labels map[string]string
 }
 
 // NewAgentServiceStatus creates a new AgentServiceStatus.
@@ -12,6 +12,7 @@ type zkConn interface {
 	Create(path string, data []byte, flags int32, acl []zk.ACL) (string, error)
 	Delete(path string, version int32) error
 	Get(path string) ([]byte, *zk.Stat, error)
+	Set(path string, data []byte, version int32) (*zk.Stat, error)
 	Children(path string) ([]string, *zk.Stat, error)
 	ChildrenW(path string) ([]string, *zk.Stat, <-chan zk.Event, error)
 	CreateProtectedEphemeralSequential(path string, data []byte, acl []zk.ACL) (string, error)
@@ -57,6 +58,10 @@ func (impl *zkConnImpl) Get(path string) ([]byte, *zk.Stat, error) {
 	return impl.conn.Get(path)
 }
 
+func (impl *zkConnImpl) Set(path string, data []byte, version int32) (*zk.Stat, error) {
+	return impl.conn.Set(path, data, version)
+}
+
 func (impl *zkConnImpl) Children(path string) ([]string, *zk.Stat, error) {
 	return impl.conn.Children(path)
 }
@@ -102,6 +102,7 @@ func (p *Provider) init(c *cluster.Cluster) error {
 	nodeName := fmt.Sprintf("%v@%v:%v", p.clusterName, host, port)
 	p.self = NewNode(nodeName, host, port, knownKinds)
 	p.self.SetMeta(metaKeyID, p.getID())
+	p.self.Labels = c.Config.Labels
 
 	if err = p.createClusterNode(p.clusterKey); err != nil {
 		return err
@@ -169,6 +170,22 @@ func (p *Provider) Shutdown(graceful bool) error {
 	return nil
 }
 
+// UpdateKinds sets the kinds the member registered or unregistered at runtime on its node
+func (p *Provider) UpdateKinds(kinds []string) error {
+	if p.fullpath == "" {
+		return nil
+	}
+
+	p.self.Kinds = kinds
+	data, err := p.self.Serialize()
+	if err != nil {
+		return err
+	}
+
+	_, err = p.conn.Set(p.fullpath, data, -1)
+	return err
+}
+
 func (p *Provider) getID() string {
 	return p.self.ID
 }
@@ -1,6 +1,7 @@
 package zk
 
 import (
+	"context"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -33,7 +34,7 @@ type ClusterAndSystem struct {
 }
 
 func (self *ClusterAndSystem) Shutdown() {
-	self.Cluster.Shutdown(true)
+	_ = self.Cluster.Shutdown(context.Background(), true)
 }
 
 func (suite *ZookeeperTestSuite) start(name string, opts ...cluster.ConfigOption) *ClusterAndSystem {
@@ -42,7 +43,7 @@ func (suite *ZookeeperTestSuite) start(name string, opts ...cluster.ConfigOption
 	config := cluster.Configure(name, cp, disthash.New(), remoteConfig, opts...)
 	system := actor.NewActorSystem()
 	c := cluster.New(system, config)
-	c.StartMember()
+	suite.Require().NoError(c.StartMember(context.Background()))
 	return &ClusterAndSystem{Cluster: c, System: system}
 }
 
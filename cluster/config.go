@@ -22,12 +22,18 @@ type Config struct {
 	ClusterContextProducer                       ContextProducer
 	MemberStrategyBuilder                        func(cluster *Cluster, kind string) MemberStrategy
 	Kinds                                        map[string]*Kind
+	Labels                                       map[string]string // the labels of the member, e.g. zone or role, matched by the placement constraints of the kinds
 	TimeoutTime                                  time.Duration
 	GossipInterval                               time.Duration
 	GossipRequestTimeout                         time.Duration
 	GossipFanOut                                 int
 	GossipMaxSend                                int
-	HeartbeatExpiration                          time.Duration // Gossip heartbeat timeout. If the member does not update its heartbeat within this period, it will be added to the BlockList
+	HeartbeatExpiration                          time.Duration          // Gossip heartbeat timeout. If the member does not update its heartbeat within this period, it will be added to the BlockList
+	CPUUsage                                     func() float64         // reports the CPU usage of the member between 0 and 1, gossiped with the heartbeat
+	FailureDetectorBuilder                       func() FailureDetector // creates the failure detector blocking members whose heartbeats stopped before HeartbeatExpiration, nil only blocks on HeartbeatExpiration
+	BlockTTL                                     time.Duration          // how long members blocked by the cluster stay blocked, 0 blocks them until they are unblocked
+	SplitBrainStrategy                           SplitBrainStrategy     // decides which side of a network partition survives, nil disables the split-brain resolver
+	SplitBrainStableAfter                        time.Duration          // how long the unreachable members must not change before the split-brain resolver decides
 	PubSubConfig                                 *PubSubConfig
 }
 
@@ -43,13 +49,15 @@ func Configure(clusterName string, clusterProvider ClusterProvider, identityLook
 		Kinds:                     make(map[string]*Kind),
 		ClusterContextProducer:    newDefaultClusterContext,
 		MaxNumberOfEventsInRequestLogThrottledPeriod: defaultMaxNumberOfEvetsInRequestLogThrottledPeriod,
-		TimeoutTime:          time.Second * 5,
-		GossipInterval:       time.Millisecond * 300,
-		GossipRequestTimeout: time.Millisecond * 500,
-		GossipFanOut:         3,
-		GossipMaxSend:        50,
-		HeartbeatExpiration:  time.Second * 20,
-		PubSubConfig:         newPubSubConfig(),
+		TimeoutTime:           time.Second * 5,
+		GossipInterval:        time.Millisecond * 300,
+		GossipRequestTimeout:  time.Millisecond * 500,
+		GossipFanOut:          3,
+		GossipMaxSend:         50,
+		HeartbeatExpiration:   time.Second * 20,
+		CPUUsage:              newCPUSampler().Usage,
+		SplitBrainStableAfter: time.Second * 20,
+		PubSubConfig:          newPubSubConfig(),
 	}
 
 	for _, option := range options {
@@ -112,6 +120,10 @@ func handleStopped(c actor.ReceiverContext, next actor.ReceiverFunc, envelope *a
 	identity := GetClusterIdentity(c)
 
 	if identity != nil {
+		if kind, ok := cl.TryGetClusterKind(identity.Kind); ok {
+			kind.deactivated(c.Self())
+		}
+
 		cl.ActorSystem.EventStream.Publish(&ActivationTerminating{
 			Pid:             c.Self(),
 			ClusterIdentity: identity,
@@ -127,6 +139,12 @@ func handleStarted(c actor.ReceiverContext, next actor.ReceiverFunc, envelope *a
 	cl := GetCluster(c.ActorSystem())
 	identity := GetClusterIdentity(c)
 
+	if identity != nil {
+		if kind, ok := cl.TryGetClusterKind(identity.Kind); ok {
+			kind.activated(c.Self())
+		}
+	}
+
 	grainInit := &ClusterInit{
 		Identity: identity,
 		Cluster:  cl,
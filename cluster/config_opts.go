@@ -40,6 +40,28 @@ func WithKinds(kinds ...*Kind) ConfigOption {
 	}
 }
 
+// WithMemberStrategyBuilder sets the builder of the MemberStrategy of the kinds registered without one, e.g.
+// NewLoadAwareMemberStrategy(). Default places the partitions with rendezvous hashing and the activators round robin.
+func WithMemberStrategyBuilder(builder func(cluster *Cluster, kind string) MemberStrategy) ConfigOption {
+	return func(c *Config) {
+		c.MemberStrategyBuilder = builder
+	}
+}
+
+// WithLabels sets the labels the member is advertised with through the ClusterProvider and gossip, e.g. its zone,
+// role or hardware class. The placement constraints of the kinds match them.
+func WithLabels(labels map[string]string) ConfigOption {
+	return func(c *Config) {
+		if c.Labels == nil {
+			c.Labels = make(map[string]string, len(labels))
+		}
+
+		for key, value := range labels {
+			c.Labels[key] = value
+		}
+	}
+}
+
 // WithPubSubSubscriberTimeout sets a timeout used when delivering a message batch to a subscriber.
 // Default is 5s.
 func WithPubSubSubscriberTimeout(timeout time.Duration) ConfigOption {
@@ -55,6 +77,41 @@ func WithHeartbeatExpiration(t time.Duration) ConfigOption {
 	}
 }
 
+// WithCPUUsage sets the function reporting the CPU usage of the member between 0 and 1, e.g. the one of its
+// container. Default is the CPU time of the process over the capacity of GOMAXPROCS since the previous heartbeat,
+// it is 0 on platforms without getrusage.
+func WithCPUUsage(usage func() float64) ConfigOption {
+	return func(c *Config) {
+		c.CPUUsage = usage
+	}
+}
+
+// WithFailureDetector sets the builder of the failure detector deciding from the heartbeats of the members which
+// ones are suspected and which ones are blocked, e.g. NewPhiAccrualFailureDetector.
+// By default members are only blocked when their heartbeat is older than HeartbeatExpiration.
+func WithFailureDetector(builder func() FailureDetector) ConfigOption {
+	return func(c *Config) {
+		c.FailureDetectorBuilder = builder
+	}
+}
+
+// WithBlockTTL sets how long the members the cluster blocks, e.g. because their heartbeat expired, stay blocked
+// before they may rejoin. Default is 0, they stay blocked until Cluster.Unblock is called.
+func WithBlockTTL(ttl time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.BlockTTL = ttl
+	}
+}
+
+// WithSplitBrainResolver enables the split-brain resolver, it downs the side of a network partition the strategy
+// decides against once the unreachable members did not change for stableAfter. Default is no resolver.
+func WithSplitBrainResolver(strategy SplitBrainStrategy, stableAfter time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.SplitBrainStrategy = strategy
+		c.SplitBrainStableAfter = stableAfter
+	}
+}
+
 func WithRequestLog(enabled bool) ConfigOption {
 	return func(c *Config) {
 		c.RequestLog = enabled
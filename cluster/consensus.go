@@ -43,7 +43,6 @@ func NewGossipConsensusHandler() *gossipConsensusHandler {
 func (hdl *gossipConsensusHandler) GetID() string { return hdl.ID }
 
 func (hdl *gossipConsensusHandler) TryGetConsensus(context.Context) (interface{}, bool) {
-	// wait until our result is available
 	hdl.result.Lock()
 	defer hdl.result.Unlock()
 
@@ -52,16 +51,16 @@ func (hdl *gossipConsensusHandler) TryGetConsensus(context.Context) (interface{}
 
 func (hdl *gossipConsensusHandler) TrySetConsensus(consensus interface{}) {
 	hdl.result.Lock()
-	go func() {
-		defer hdl.result.Unlock()
+	defer hdl.result.Unlock()
 
-		hdl.result.value = consensus
-		hdl.result.consensus = true
-	}()
+	hdl.result.value = consensus
+	hdl.result.consensus = true
 }
 
 func (hdl *gossipConsensusHandler) TryResetConsensus() {
-	// this is a noop for now need to discuss the right
-	// approach for check waiting in Go as might be another
-	// way of expressing this
+	hdl.result.Lock()
+	defer hdl.result.Unlock()
+
+	hdl.result.value = nil
+	hdl.result.consensus = false
 }
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package cluster
+
+import "time"
+
+// processCPUTime is not available on this platform, the CPU usage is reported as 0
+func processCPUTime() (time.Duration, bool) {
+	return 0, false
+}
@@ -59,12 +59,15 @@ func (dcc *DefaultContext) Request(identity, kind string, message interface{}, o
 	ctx, cancel := context.WithTimeout(context.Background(), ttl)
 	defer cancel()
 
+	var reason string // why the last attempt failed
+
 selectloop:
 	for {
 		select {
 		case <-ctx.Done():
 			// TODO: handler throttling and messaging here
 			err = fmt.Errorf("request failed: %w", ctx.Err())
+			reason = requestFailureReason(ctx.Err())
 
 			break selectloop
 		default:
@@ -76,7 +79,8 @@ selectloop:
 			pid := dcc.getPid(identity, kind)
 			if pid == nil {
 				dcc.cluster.Logger().Debug("Requesting PID from IdentityLookup but got nil", slog.String("identity", identity), slog.String("kind", kind))
-				counter = callConfig.RetryAction(counter)
+				reason = RequestFailureNoActivation
+				counter = dcc.retry(callConfig, counter, kind)
 				continue
 			}
 
@@ -87,22 +91,29 @@ selectloop:
 			}
 			if err != nil {
 				dcc.cluster.Logger().Error("cluster.RequestFuture failed", slog.Any("error", err), slog.Any("pid", pid))
+				reason = requestFailureReason(err)
 				switch err {
 				case actor.ErrTimeout, remote.ErrTimeout, actor.ErrDeadLetter, remote.ErrDeadLetter:
-					counter = callConfig.RetryAction(counter)
+					counter = dcc.retry(callConfig, counter, kind)
 					dcc.cluster.PidCache.Remove(identity, kind)
 					continue
 				default:
 					break selectloop
 				}
 			}
-
-			// TODO: add metrics to increment retries
 		}
 	}
 
 	totalTime := time.Since(start)
-	// TODO: add metrics ot set histogram for total request time
+	dcc.cluster.metrics.requestDuration(kind, totalTime)
+
+	if resp == nil && err != nil {
+		if reason == "" {
+			reason = RequestFailureError
+		}
+
+		dcc.cluster.metrics.requestFailure(kind, reason)
+	}
 
 	if contextError := ctx.Err(); contextError != nil && cfg.requestLogThrottle() == actor.Open {
 		// context timeout exceeded, report and return
@@ -143,7 +154,7 @@ func (dcc *DefaultContext) RequestFuture(identity string, kind string, message i
 			pid := dcc.getPid(identity, kind)
 			if pid == nil {
 				dcc.cluster.Logger().Debug("Requesting PID from IdentityLookup but got nil", slog.String("identity", identity), slog.String("kind", kind))
-				counter = callConfig.RetryAction(counter)
+				counter = dcc.retry(callConfig, counter, kind)
 				continue
 			}
 
@@ -153,12 +164,24 @@ func (dcc *DefaultContext) RequestFuture(identity string, kind string, message i
 	}
 }
 
+// retry counts the retry of the request and runs the retry action of the call
+func (dcc *DefaultContext) retry(callConfig *GrainCallConfig, counter int, kind string) int {
+	dcc.cluster.metrics.requestRetry(kind)
+
+	return callConfig.RetryAction(counter)
+}
+
 // gets the cached PID for the given identity
 // it can return nil if none is found.
 func (dcc *DefaultContext) getPid(identity, kind string) *actor.PID {
 	pid, _ := dcc.cluster.PidCache.Get(identity, kind)
+	dcc.cluster.metrics.pidCache(kind, pid != nil)
+
 	if pid == nil {
+		start := time.Now()
 		pid = dcc.cluster.Get(identity, kind)
+		dcc.cluster.metrics.identityLookup(kind, time.Since(start))
+
 		if pid != nil {
 			dcc.cluster.PidCache.Set(identity, kind, pid)
 		}
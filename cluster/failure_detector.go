@@ -0,0 +1,231 @@
+package cluster
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// FailureDetector decides from the arrival times of the heartbeats of a member whether it is still available.
+// A member whose heartbeats stopped is first suspected and then considered unavailable, it is then blocked.
+type FailureDetector interface {
+	// Heartbeat records the arrival of a heartbeat of the member
+	Heartbeat(memberID string, at time.Time)
+	// Suspicion returns how strongly the member is suspected to have failed, 0 when there is no doubt it is available
+	Suspicion(memberID string, now time.Time) float64
+	// IsSuspected returns true once the suspicion level is high enough to warn about the member
+	IsSuspected(memberID string, now time.Time) bool
+	// IsAvailable returns false once the suspicion level is high enough to block the member
+	IsAvailable(memberID string, now time.Time) bool
+	// Remove forgets the heartbeats of the member, e.g. once it left the cluster
+	Remove(memberID string)
+}
+
+// MemberSuspected is published on the EventStream when the failure detector starts suspecting a member,
+// the member is blocked if its suspicion level keeps rising
+type MemberSuspected struct {
+	MemberID  string
+	Member    *Member // nil when the member is not part of the topology
+	Suspicion float64
+}
+
+// MemberReachable is published on the EventStream when a suspected member is no longer suspected
+type MemberReachable struct {
+	MemberID string
+	Member   *Member // nil when the member is not part of the topology
+}
+
+// PhiAccrualOption configures the failure detector created by NewPhiAccrualFailureDetector
+type PhiAccrualOption func(detector *PhiAccrualFailureDetector)
+
+// WithPhiThreshold sets the phi above which a member is unavailable, defaults to 8. A phi of 8 means a chance of
+// about 10^-8 that a heartbeat of an available member arrives even later.
+func WithPhiThreshold(threshold float64) PhiAccrualOption {
+	return func(detector *PhiAccrualFailureDetector) {
+		detector.threshold = threshold
+	}
+}
+
+// WithPhiSuspectThreshold sets the phi above which a member is suspected, defaults to 3
+func WithPhiSuspectThreshold(threshold float64) PhiAccrualOption {
+	return func(detector *PhiAccrualFailureDetector) {
+		detector.suspectThreshold = threshold
+	}
+}
+
+// WithPhiMaxSampleSize sets the number of most recent heartbeat intervals the distribution is learned from,
+// defaults to 1000
+func WithPhiMaxSampleSize(size int) PhiAccrualOption {
+	return func(detector *PhiAccrualFailureDetector) {
+		detector.maxSampleSize = size
+	}
+}
+
+// WithPhiMinStdDeviation sets the minimum standard deviation of the heartbeat intervals, so that very regular
+// heartbeats do not make the detector too sensitive, defaults to 100ms
+func WithPhiMinStdDeviation(deviation time.Duration) PhiAccrualOption {
+	return func(detector *PhiAccrualFailureDetector) {
+		detector.minStdDeviation = deviation
+	}
+}
+
+// WithPhiAcceptableHeartbeatPause sets the pause, e.g. caused by garbage collection or network hiccups, tolerated
+// on top of the learned intervals, defaults to 3 seconds
+func WithPhiAcceptableHeartbeatPause(pause time.Duration) PhiAccrualOption {
+	return func(detector *PhiAccrualFailureDetector) {
+		detector.acceptablePause = pause
+	}
+}
+
+// WithPhiFirstHeartbeatEstimate sets the heartbeat interval assumed until intervals were observed, defaults
+// to 1 second
+func WithPhiFirstHeartbeatEstimate(estimate time.Duration) PhiAccrualOption {
+	return func(detector *PhiAccrualFailureDetector) {
+		detector.firstHeartbeatEstimate = estimate
+	}
+}
+
+// PhiAccrualFailureDetector is the phi accrual failure detector of Hayashibara et al. It learns the distribution
+// of the heartbeat intervals of every member, the suspicion level phi is derived from the probability that
+// a heartbeat arrives later than the time elapsed since the last one.
+type PhiAccrualFailureDetector struct {
+	threshold              float64
+	suspectThreshold       float64
+	maxSampleSize          int
+	minStdDeviation        time.Duration
+	acceptablePause        time.Duration
+	firstHeartbeatEstimate time.Duration
+
+	mu      sync.Mutex
+	members map[string]*heartbeatHistory
+}
+
+var _ FailureDetector = (*PhiAccrualFailureDetector)(nil)
+
+// NewPhiAccrualFailureDetector creates a phi accrual failure detector
+func NewPhiAccrualFailureDetector(opts ...PhiAccrualOption) *PhiAccrualFailureDetector {
+	detector := &PhiAccrualFailureDetector{
+		threshold:              8,
+		suspectThreshold:       3,
+		maxSampleSize:          1000,
+		minStdDeviation:        100 * time.Millisecond,
+		acceptablePause:        3 * time.Second,
+		firstHeartbeatEstimate: time.Second,
+		members:                make(map[string]*heartbeatHistory),
+	}
+
+	for _, opt := range opts {
+		opt(detector)
+	}
+
+	return detector
+}
+
+func (d *PhiAccrualFailureDetector) Heartbeat(memberID string, at time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	history, ok := d.members[memberID]
+	if !ok {
+		// seeds the distribution with the estimate, the real intervals replace it over time
+		history = &heartbeatHistory{maxSize: d.maxSampleSize}
+		estimate := float64(d.firstHeartbeatEstimate.Milliseconds())
+		history.add(estimate - estimate/4)
+		history.add(estimate + estimate/4)
+		history.last = at
+		d.members[memberID] = history
+
+		return
+	}
+
+	if interval := at.Sub(history.last); interval > 0 {
+		history.add(float64(interval.Milliseconds()))
+		history.last = at
+	}
+}
+
+func (d *PhiAccrualFailureDetector) Suspicion(memberID string, now time.Time) float64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	history, ok := d.members[memberID]
+	if !ok {
+		return 0
+	}
+
+	elapsed := float64(now.Sub(history.last).Milliseconds())
+	mean := history.mean() + float64(d.acceptablePause.Milliseconds())
+	stdDeviation := math.Max(history.stdDeviation(), float64(d.minStdDeviation.Milliseconds()))
+
+	return phi(elapsed, mean, stdDeviation)
+}
+
+func (d *PhiAccrualFailureDetector) IsSuspected(memberID string, now time.Time) bool {
+	return d.Suspicion(memberID, now) >= d.suspectThreshold
+}
+
+func (d *PhiAccrualFailureDetector) IsAvailable(memberID string, now time.Time) bool {
+	return d.Suspicion(memberID, now) < d.threshold
+}
+
+func (d *PhiAccrualFailureDetector) Remove(memberID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.members, memberID)
+}
+
+// phi returns -log10 of the probability that a heartbeat arrives later than elapsed, using a logistic
+// approximation of the cumulative normal distribution
+func phi(elapsed, mean, stdDeviation float64) float64 {
+	y := (elapsed - mean) / stdDeviation
+	e := math.Exp(-y * (1.5976 + 0.070566*y*y))
+
+	var p float64
+	if elapsed > mean {
+		p = -math.Log10(e / (1 + e))
+	} else {
+		p = -math.Log10(1 - 1/(1+e))
+	}
+
+	if math.IsInf(p, 1) || math.IsNaN(p) {
+		return math.MaxFloat64
+	}
+
+	return math.Max(p, 0)
+}
+
+// heartbeatHistory keeps the most recent heartbeat intervals of a member in milliseconds
+type heartbeatHistory struct {
+	maxSize    int
+	intervals  []float64
+	next       int
+	sum        float64
+	squaredSum float64
+	last       time.Time
+}
+
+func (h *heartbeatHistory) add(interval float64) {
+	if len(h.intervals) < h.maxSize {
+		h.intervals = append(h.intervals, interval)
+	} else {
+		dropped := h.intervals[h.next]
+		h.sum -= dropped
+		h.squaredSum -= dropped * dropped
+		h.intervals[h.next] = interval
+		h.next = (h.next + 1) % h.maxSize
+	}
+
+	h.sum += interval
+	h.squaredSum += interval * interval
+}
+
+func (h *heartbeatHistory) mean() float64 {
+	return h.sum / float64(len(h.intervals))
+}
+
+func (h *heartbeatHistory) stdDeviation() float64 {
+	mean := h.mean()
+
+	return math.Sqrt(math.Max(h.squaredSum/float64(len(h.intervals))-mean*mean, 0))
+}
@@ -0,0 +1,85 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhiAccrualFailureDetector_UnknownMemberIsAvailable(t *testing.T) {
+	detector := NewPhiAccrualFailureDetector()
+
+	assert.Zero(t, detector.Suspicion("member", time.Now()))
+	assert.True(t, detector.IsAvailable("member", time.Now()))
+	assert.False(t, detector.IsSuspected("member", time.Now()))
+}
+
+func TestPhiAccrualFailureDetector_SuspicionRisesWhenHeartbeatsStop(t *testing.T) {
+	detector := NewPhiAccrualFailureDetector(
+		WithPhiAcceptableHeartbeatPause(0),
+		WithPhiFirstHeartbeatEstimate(100*time.Millisecond),
+		WithPhiMinStdDeviation(10*time.Millisecond),
+	)
+
+	now := time.Now()
+	for i := 0; i < 20; i++ {
+		now = now.Add(100 * time.Millisecond)
+		detector.Heartbeat("member", now)
+	}
+
+	assert.Less(t, detector.Suspicion("member", now.Add(50*time.Millisecond)), 1.0)
+	assert.True(t, detector.IsAvailable("member", now.Add(100*time.Millisecond)))
+
+	previous := 0.0
+	for _, elapsed := range []time.Duration{120, 150, 200, 400} {
+		suspicion := detector.Suspicion("member", now.Add(elapsed*time.Millisecond))
+		assert.Greater(t, suspicion, previous)
+		previous = suspicion
+	}
+
+	assert.True(t, detector.IsSuspected("member", now.Add(150*time.Millisecond)))
+	assert.False(t, detector.IsAvailable("member", now.Add(time.Second)))
+}
+
+func TestPhiAccrualFailureDetector_LearnsIrregularHeartbeats(t *testing.T) {
+	regular := NewPhiAccrualFailureDetector(WithPhiAcceptableHeartbeatPause(0), WithPhiMinStdDeviation(time.Millisecond))
+	irregular := NewPhiAccrualFailureDetector(WithPhiAcceptableHeartbeatPause(0), WithPhiMinStdDeviation(time.Millisecond))
+
+	now := time.Now()
+	regularAt, irregularAt := now, now
+	for i := 0; i < 100; i++ {
+		regularAt = regularAt.Add(time.Second)
+		regular.Heartbeat("member", regularAt)
+
+		interval := 500 * time.Millisecond
+		if i%2 == 0 {
+			interval = 1500 * time.Millisecond
+		}
+		irregularAt = irregularAt.Add(interval)
+		irregular.Heartbeat("member", irregularAt)
+	}
+
+	// the same pause is more suspicious for a member whose heartbeats are regular
+	assert.Greater(t, regular.Suspicion("member", regularAt.Add(1300*time.Millisecond)), irregular.Suspicion("member", irregularAt.Add(1300*time.Millisecond)))
+}
+
+func TestPhiAccrualFailureDetector_Remove(t *testing.T) {
+	detector := NewPhiAccrualFailureDetector()
+
+	detector.Heartbeat("member", time.Now().Add(-time.Hour))
+	assert.False(t, detector.IsAvailable("member", time.Now()))
+
+	detector.Remove("member")
+	assert.True(t, detector.IsAvailable("member", time.Now()))
+}
+
+func TestConfigure_FailureDetectorIsOptIn(t *testing.T) {
+	config := Configure("mycluster", nil, nil, nil)
+	assert.Nil(t, config.FailureDetectorBuilder)
+
+	config = Configure("mycluster", nil, nil, nil, WithFailureDetector(func() FailureDetector {
+		return NewPhiAccrualFailureDetector()
+	}))
+	assert.IsType(t, &PhiAccrualFailureDetector{}, config.FailureDetectorBuilder())
+}
@@ -343,6 +343,190 @@ func (x *GossipMap) GetItems() map[string]*anypb.Any {
 	return nil
 }
 
+// a member blocked by the member gossiping its block list
+type BlockedMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId                  string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason                    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BlockedAtUnixMilliseconds int64  `protobuf:"varint,3,opt,name=blocked_at_unix_milliseconds,json=blockedAtUnixMilliseconds,proto3" json:"blocked_at_unix_milliseconds,omitempty"`
+	TtlMilliseconds           int64  `protobuf:"varint,4,opt,name=ttl_milliseconds,json=ttlMilliseconds,proto3" json:"ttl_milliseconds,omitempty"` //0 blocks the member until it is unblocked
+}
+
+func (x *BlockedMember) Reset() {
+	*x = BlockedMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gossip_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockedMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedMember) ProtoMessage() {}
+
+func (x *BlockedMember) ProtoReflect() protoreflect.Message {
+	mi := &file_gossip_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedMember.ProtoReflect.Descriptor instead.
+func (*BlockedMember) Descriptor() ([]byte, []int) {
+	return file_gossip_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BlockedMember) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *BlockedMember) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockedMember) GetBlockedAtUnixMilliseconds() int64 {
+	if x != nil {
+		return x.BlockedAtUnixMilliseconds
+	}
+	return 0
+}
+
+func (x *BlockedMember) GetTtlMilliseconds() int64 {
+	if x != nil {
+		return x.TtlMilliseconds
+	}
+	return 0
+}
+
+// a member unblocked by the member gossiping its block list, blocks older than the unblock are not applied
+type UnblockedMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId                    string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	UnblockedAtUnixMilliseconds int64  `protobuf:"varint,2,opt,name=unblocked_at_unix_milliseconds,json=unblockedAtUnixMilliseconds,proto3" json:"unblocked_at_unix_milliseconds,omitempty"`
+}
+
+func (x *UnblockedMember) Reset() {
+	*x = UnblockedMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gossip_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockedMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockedMember) ProtoMessage() {}
+
+func (x *UnblockedMember) ProtoReflect() protoreflect.Message {
+	mi := &file_gossip_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockedMember.ProtoReflect.Descriptor instead.
+func (*UnblockedMember) Descriptor() ([]byte, []int) {
+	return file_gossip_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnblockedMember) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UnblockedMember) GetUnblockedAtUnixMilliseconds() int64 {
+	if x != nil {
+		return x.UnblockedAtUnixMilliseconds
+	}
+	return 0
+}
+
+// the block list of a member, gossiped with the "blocklist" key
+type BlockListState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocked   []*BlockedMember   `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"`
+	Unblocked []*UnblockedMember `protobuf:"bytes,2,rep,name=unblocked,proto3" json:"unblocked,omitempty"`
+}
+
+func (x *BlockListState) Reset() {
+	*x = BlockListState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gossip_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockListState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockListState) ProtoMessage() {}
+
+func (x *BlockListState) ProtoReflect() protoreflect.Message {
+	mi := &file_gossip_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockListState.ProtoReflect.Descriptor instead.
+func (*BlockListState) Descriptor() ([]byte, []int) {
+	return file_gossip_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlockListState) GetBlocked() []*BlockedMember {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+func (x *BlockListState) GetUnblocked() []*UnblockedMember {
+	if x != nil {
+		return x.Unblocked
+	}
+	return nil
+}
+
 var File_gossip_proto protoreflect.FileDescriptor
 
 var file_gossip_proto_rawDesc = []byte{
@@ -400,10 +584,37 @@ var file_gossip_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x79, 0x6e, 0x6b, 0x72, 0x6f, 0x6e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x0d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x3f, 0x0a, 0x1c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x55, 0x6e, 0x69, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x74, 0x6c, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x74, 0x6c, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x1e, 0x75,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x1b, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x55, 0x6e, 0x69, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x7a, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x09, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x2c, 0x5a, 0x2a,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x79, 0x6e,
+	0x6b, 0x72, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2d,
+	0x67, 0x6f, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -418,7 +629,7 @@ func file_gossip_proto_rawDescGZIP() []byte {
 	return file_gossip_proto_rawDescData
 }
 
-var file_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_gossip_proto_goTypes = []interface{}{
 	(*GossipRequest)(nil),     // 0: cluster.GossipRequest
 	(*GossipResponse)(nil),    // 1: cluster.GossipResponse
@@ -426,26 +637,31 @@ var file_gossip_proto_goTypes = []interface{}{
 	(*GossipMemberState)(nil), // 3: cluster.GossipMemberState
 	(*GossipKeyValue)(nil),    // 4: cluster.GossipKeyValue
 	(*GossipMap)(nil),         // 5: cluster.GossipMap
-	nil,                       // 6: cluster.GossipState.MembersEntry
-	nil,                       // 7: cluster.GossipMemberState.ValuesEntry
-	nil,                       // 8: cluster.GossipMap.ItemsEntry
-	(*anypb.Any)(nil),         // 9: google.protobuf.Any
+	(*BlockedMember)(nil),     // 6: cluster.BlockedMember
+	(*UnblockedMember)(nil),   // 7: cluster.UnblockedMember
+	(*BlockListState)(nil),    // 8: cluster.BlockListState
+	nil,                       // 9: cluster.GossipState.MembersEntry
+	nil,                       // 10: cluster.GossipMemberState.ValuesEntry
+	nil,                       // 11: cluster.GossipMap.ItemsEntry
+	(*anypb.Any)(nil),         // 12: google.protobuf.Any
 }
 var file_gossip_proto_depIdxs = []int32{
-	2, // 0: cluster.GossipRequest.state:type_name -> cluster.GossipState
-	2, // 1: cluster.GossipResponse.state:type_name -> cluster.GossipState
-	6, // 2: cluster.GossipState.members:type_name -> cluster.GossipState.MembersEntry
-	7, // 3: cluster.GossipMemberState.values:type_name -> cluster.GossipMemberState.ValuesEntry
-	9, // 4: cluster.GossipKeyValue.value:type_name -> google.protobuf.Any
-	8, // 5: cluster.GossipMap.items:type_name -> cluster.GossipMap.ItemsEntry
-	3, // 6: cluster.GossipState.MembersEntry.value:type_name -> cluster.GossipMemberState
-	4, // 7: cluster.GossipMemberState.ValuesEntry.value:type_name -> cluster.GossipKeyValue
-	9, // 8: cluster.GossipMap.ItemsEntry.value:type_name -> google.protobuf.Any
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	2,  // 0: cluster.GossipRequest.state:type_name -> cluster.GossipState
+	2,  // 1: cluster.GossipResponse.state:type_name -> cluster.GossipState
+	9,  // 2: cluster.GossipState.members:type_name -> cluster.GossipState.MembersEntry
+	10, // 3: cluster.GossipMemberState.values:type_name -> cluster.GossipMemberState.ValuesEntry
+	12, // 4: cluster.GossipKeyValue.value:type_name -> google.protobuf.Any
+	11, // 5: cluster.GossipMap.items:type_name -> cluster.GossipMap.ItemsEntry
+	6,  // 6: cluster.BlockListState.blocked:type_name -> cluster.BlockedMember
+	7,  // 7: cluster.BlockListState.unblocked:type_name -> cluster.UnblockedMember
+	3,  // 8: cluster.GossipState.MembersEntry.value:type_name -> cluster.GossipMemberState
+	4,  // 9: cluster.GossipMemberState.ValuesEntry.value:type_name -> cluster.GossipKeyValue
+	12, // 10: cluster.GossipMap.ItemsEntry.value:type_name -> google.protobuf.Any
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_gossip_proto_init() }
@@ -526,6 +742,42 @@ func file_gossip_proto_init() {
 				return nil
 			}
 		}
+		file_gossip_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockedMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gossip_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockedMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gossip_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockListState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -533,7 +785,7 @@ func file_gossip_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gossip_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/eventstream"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -47,8 +48,19 @@ type Gossiper struct {
 	// Channel use to stop the gossip loop
 	close chan struct{}
 
+	// Subscription forwarding the cluster topology to the gossip actor
+	topologySub *eventstream.Subscription
+
 	// Message throttler
 	throttler actor.ShouldThrottle
+
+	// Failure detector fed with the heartbeats of the members, only used by the gossip loop
+	failureDetector FailureDetector
+	heartbeats      map[string]int64
+	suspected       map[string]struct{}
+
+	// Version of the BlockList last gossiped, only used by the gossip loop
+	blockListVersion uint64
 }
 
 // Creates a new Gossiper value and return it back
@@ -58,6 +70,12 @@ func newGossiper(cl *Cluster, opts ...Option) (*Gossiper, error) {
 		GossipActorName: DefaultGossipActorName,
 		cluster:         cl,
 		close:           make(chan struct{}),
+		heartbeats:      make(map[string]int64),
+		suspected:       make(map[string]struct{}),
+	}
+
+	if cl.Config.FailureDetectorBuilder != nil {
+		gossiper.failureDetector = cl.Config.FailureDetectorBuilder()
 	}
 
 	// apply any given options
@@ -275,13 +293,13 @@ func (g *Gossiper) StartGossiping() error {
 			g.cluster.Config.GossipMaxSend,
 			system,
 		)
-	}), g.GossipActorName)
+	}, actor.WithSystemActor()), g.GossipActorName)
 	if err != nil {
 		g.cluster.Logger().Error("Failed to start gossip actor", slog.Any("error", err))
 		return err
 	}
 
-	g.cluster.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+	g.topologySub = g.cluster.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
 		if topology, ok := evt.(*ClusterTopology); ok {
 			g.cluster.ActorSystem.Root.Send(g.pid, topology)
 		}
@@ -301,6 +319,7 @@ func (g *Gossiper) Shutdown() {
 	g.cluster.Logger().Info("Shutting down gossip")
 
 	close(g.close)
+	g.cluster.ActorSystem.EventStream.Unsubscribe(g.topologySub)
 
 	err := g.cluster.ActorSystem.Root.StopFuture(g.pid).Wait()
 	if err != nil {
@@ -326,21 +345,43 @@ breakLoop:
 		case <-ticker.C:
 			g.blockExpiredHeartbeats()
 			g.blockGracefullyLeft()
-			g.SetState(HeartbeatKey, &MemberHeartbeat{
-				ActorStatistics: &ActorStatistics{
-					ActorCount: g.GetActorCount(),
-				},
-			})
+			g.cluster.unblockExpired()
+			g.gossipBlockList()
+			g.gossipHeartbeat()
 			g.SendState()
 		}
 	}
 }
 
+// gossipHeartbeat gossips the heartbeat of the local member along with its load
+func (g *Gossiper) gossipHeartbeat() {
+	heartbeat := &MemberHeartbeat{
+		ActorStatistics: &ActorStatistics{
+			ActorCount:    g.GetActorCount(),
+			MailboxLength: g.getMailboxLength(),
+		},
+	}
+
+	if g.cluster.Config.CPUUsage != nil {
+		heartbeat.CpuUsage = g.cluster.Config.CPUUsage()
+	}
+
+	g.cluster.loads.update(g.cluster.ActorSystem.ID, heartbeat)
+	g.SetState(HeartbeatKey, heartbeat)
+}
+
+func (g *Gossiper) getMailboxLength() int64 {
+	var length int64
+	for _, kind := range g.cluster.activatedKinds() {
+		length += kind.MailboxLength(g.cluster.ActorSystem)
+	}
+
+	return length
+}
+
 func (g *Gossiper) GetActorCount() map[string]int64 {
 	m := make(map[string]int64)
-	clusterKinds := g.cluster.GetClusterKinds()
-	for _, kindName := range clusterKinds {
-		kind := g.cluster.GetClusterKind(kindName)
+	for kindName, kind := range g.cluster.activatedKinds() {
 		m[kindName] = int64(kind.Count())
 	}
 	g.cluster.Logger().Debug("Actor Count", slog.Any("count", m))
@@ -348,7 +389,8 @@ func (g *Gossiper) GetActorCount() map[string]int64 {
 	return m
 }
 
-// blockExpiredHeartbeats blocks members that have not sent a heartbeat for a long time
+// blockExpiredHeartbeats blocks members the failure detector considers unavailable or that have not sent
+// a heartbeat for HeartbeatExpiration
 func (g *Gossiper) blockExpiredHeartbeats() {
 	if g.cluster.Config.GossipInterval == 0 {
 		return
@@ -362,18 +404,72 @@ func (g *Gossiper) blockExpiredHeartbeats() {
 	blockList := remote.GetRemote(g.cluster.ActorSystem).BlockList()
 
 	blocked := make([]string, 0)
+	now := time.Now()
 
 	for k, v := range t {
-		if k != g.cluster.ActorSystem.ID &&
-			!blockList.IsBlocked(k) &&
-			time.Now().Sub(time.UnixMilli(v.LocalTimestampUnixMilliseconds)) > g.cluster.Config.HeartbeatExpiration {
+		if k == g.cluster.ActorSystem.ID || blockList.IsBlocked(k) {
+			continue
+		}
+
+		received := time.UnixMilli(v.LocalTimestampUnixMilliseconds)
+		if unblockedAt, ok := blockList.UnblockedAt(k); ok && unblockedAt.After(received) {
+			// an unblocked member gets a full heartbeat period to send a new heartbeat
+			received = unblockedAt
+		}
+
+		if g.failureDetector != nil && g.heartbeats[k] != received.UnixMilli() {
+			g.heartbeats[k] = received.UnixMilli()
+			g.failureDetector.Heartbeat(k, received)
+		}
+
+		if now.Sub(received) > g.cluster.Config.HeartbeatExpiration ||
+			(g.failureDetector != nil && !g.failureDetector.IsAvailable(k, now)) {
 			blocked = append(blocked, k)
+			continue
 		}
+
+		g.updateSuspicion(k, now)
 	}
 
 	if len(blocked) > 0 {
 		g.cluster.Logger().Info("Blocking members due to expired heartbeat", slog.String("members", strings.Join(blocked, ",")))
-		blockList.Block(blocked...)
+		g.cluster.block(BlockReasonHeartbeatExpired, blocked...)
+
+		for _, k := range blocked {
+			g.forgetMember(k)
+		}
+	}
+}
+
+// updateSuspicion publishes MemberSuspected or MemberReachable when the member became suspected or is no longer
+func (g *Gossiper) updateSuspicion(memberID string, now time.Time) {
+	if g.failureDetector == nil {
+		return
+	}
+
+	_, wasSuspected := g.suspected[memberID]
+	member := g.cluster.MemberList.Members().GetMemberById(memberID)
+
+	switch suspected := g.failureDetector.IsSuspected(memberID, now); {
+	case suspected && !wasSuspected:
+		g.suspected[memberID] = struct{}{}
+		suspicion := g.failureDetector.Suspicion(memberID, now)
+		g.cluster.Logger().Warn("Member suspected", slog.String("member", memberID), slog.Float64("suspicion", suspicion))
+		g.cluster.ActorSystem.EventStream.Publish(&MemberSuspected{MemberID: memberID, Member: member, Suspicion: suspicion})
+	case !suspected && wasSuspected:
+		delete(g.suspected, memberID)
+		g.cluster.Logger().Info("Member reachable again", slog.String("member", memberID))
+		g.cluster.ActorSystem.EventStream.Publish(&MemberReachable{MemberID: memberID, Member: member})
+	}
+}
+
+// forgetMember drops the heartbeats of a blocked member
+func (g *Gossiper) forgetMember(memberID string) {
+	delete(g.heartbeats, memberID)
+	delete(g.suspected, memberID)
+
+	if g.failureDetector != nil {
+		g.failureDetector.Remove(memberID)
 	}
 }
 
@@ -395,8 +491,21 @@ func (g *Gossiper) blockGracefullyLeft() {
 	}
 	if len(gracefullyLeft) > 0 {
 		g.cluster.Logger().Info("Blocking members due to gracefully leaving", slog.String("members", strings.Join(gracefullyLeft, ",")))
-		blockList.Block(gracefullyLeft...)
+		g.cluster.block(BlockReasonGracefullyLeft, gracefullyLeft...)
+	}
+}
+
+// gossipBlockList sets the BlockList as gossip state whenever it changed
+func (g *Gossiper) gossipBlockList() {
+	blockList := g.cluster.Remote.BlockList()
+
+	version := blockList.Version()
+	if version == g.blockListVersion {
+		return
 	}
+
+	g.blockListVersion = version
+	g.SetState(BlockListKey, newBlockListState(blockList))
 }
 
 func (g *Gossiper) throttledLog(counter int32) {
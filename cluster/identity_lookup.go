@@ -1,6 +1,8 @@
 package cluster
 
 import (
+	"context"
+
 	"github.com/asynkron/protoactor-go/actor"
 )
 
@@ -15,6 +17,15 @@ type IdentityLookup interface {
 	Shutdown()
 }
 
+// ReadyIdentityLookup is implemented by the identity lookups that are not ready to place activations as soon as they
+// are set up, the cluster waits for them before StartMember and StartClient return
+type ReadyIdentityLookup interface {
+	IdentityLookup
+
+	// WaitReady blocks until the identity lookup is ready or the context is done
+	WaitReady(ctx context.Context) error
+}
+
 // StorageLookup contains
 type StorageLookup interface {
 	TryGetExistingActivation(clusterIdentity *ClusterIdentity) *StoredActivation
@@ -1,10 +1,14 @@
 package disthash
 
 import (
+	"context"
+
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/cluster"
 )
 
+var _ cluster.ReadyIdentityLookup = &IdentityLookup{}
+
 type IdentityLookup struct {
 	partitionManager *Manager
 }
@@ -24,6 +28,21 @@ func (p *IdentityLookup) RemovePid(clusterIdentity *cluster.ClusterIdentity, pid
 func (p *IdentityLookup) Setup(cluster *cluster.Cluster, kinds []string, isClient bool) {
 	p.partitionManager = newPartitionManager(cluster)
 	p.partitionManager.Start()
+
+	// clients do not own activations, there is nothing to wait for
+	if isClient {
+		p.partitionManager.markReady()
+	}
+}
+
+// WaitReady blocks until the placement actor of this member has handled a topology the member is part of
+func (p *IdentityLookup) WaitReady(ctx context.Context) error {
+	select {
+	case <-p.partitionManager.ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (p *IdentityLookup) Shutdown() {
@@ -2,6 +2,7 @@ package disthash
 
 import (
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
@@ -18,12 +19,15 @@ type Manager struct {
 	topologySub    *eventstream.Subscription
 	placementActor *actor.PID
 	rdv            *clustering.Rendezvous
+	ready          chan struct{}
+	readyOnce      sync.Once
 }
 
 func newPartitionManager(c *clustering.Cluster) *Manager {
 	return &Manager{
 		cluster: c,
 		rdv:     clustering.NewRendezvous(),
+		ready:   make(chan struct{}),
 	}
 }
 
@@ -31,7 +35,7 @@ func (pm *Manager) Start() {
 	pm.cluster.Logger().Info("Started partition manager")
 	system := pm.cluster.ActorSystem
 
-	activatorProps := actor.PropsFromProducer(func() actor.Actor { return newPlacementActor(pm.cluster, pm) })
+	activatorProps := actor.PropsFromProducer(func() actor.Actor { return newPlacementActor(pm.cluster, pm) }, actor.WithSystemActor())
 	pm.placementActor, _ = system.Root.SpawnNamed(activatorProps, PartitionActivatorActorName)
 	pm.cluster.Logger().Info("Started partition placement actor")
 
@@ -55,6 +59,13 @@ func (pm *Manager) Stop() {
 	pm.cluster.Logger().Info("Stopped PartitionManager")
 }
 
+// markReady is called once the placement actor handled a topology this member is part of
+func (pm *Manager) markReady() {
+	pm.readyOnce.Do(func() {
+		close(pm.ready)
+	})
+}
+
 func (pm *Manager) PidOfActivatorActor(addr string) *actor.PID {
 	return actor.NewPID(addr, PartitionActivatorActorName)
 }
@@ -66,8 +77,7 @@ func (pm *Manager) onClusterTopology(tplg *clustering.ClusterTopology) {
 		pm.cluster.Logger().Info("Got member", slog.Any("member", m))
 	}
 
-	pm.rdv = clustering.NewRendezvous()
-	pm.rdv.UpdateMembers(tplg.Members)
+	pm.rdv = pm.cluster.NewRendezvous(tplg.Members)
 	pm.cluster.ActorSystem.Root.Send(pm.placementActor, tplg)
 }
 
@@ -12,10 +12,21 @@ type GrainMeta struct {
 	PID *actor.PID
 }
 
+// hostedActivation is an activation hosted on behalf of the member owning the partition of its identity
+type hostedActivation struct {
+	GrainMeta
+	owner string
+}
+
 type placementActor struct {
 	cluster          *clustering.Cluster
 	partitionManager *Manager
-	actors           map[string]GrainMeta
+	// actors are the activations of the identities of the partitions this member owns, wherever they are hosted
+	actors map[string]GrainMeta
+	// hosted are the activations of the identities of the partitions other members own
+	hosted map[string]hostedActivation
+	// pending are the activations requested from other members
+	pending map[string]*actor.Future
 }
 
 func newPlacementActor(c *clustering.Cluster, pm *Manager) *placementActor {
@@ -23,6 +34,8 @@ func newPlacementActor(c *clustering.Cluster, pm *Manager) *placementActor {
 		cluster:          c,
 		partitionManager: pm,
 		actors:           map[string]GrainMeta{},
+		hosted:           map[string]hostedActivation{},
+		pending:          map[string]*actor.Future{},
 	}
 }
 
@@ -39,6 +52,8 @@ func (p *placementActor) Receive(ctx actor.Context) {
 		p.onTerminated(msg)
 	case *clustering.ActivationRequest:
 		p.onActivationRequest(msg, ctx)
+	case *clustering.HostedActivationRequest:
+		p.onHostedActivationRequest(msg, ctx)
 	case *clustering.ClusterTopology:
 		p.onClusterTopology(msg, ctx)
 	default:
@@ -47,9 +62,23 @@ func (p *placementActor) Receive(ctx actor.Context) {
 }
 
 func (p *placementActor) onTerminated(msg *actor.Terminated) {
+	if key, hosted, ok := p.pidToHosted(msg.Who); ok {
+		// the owner watches the activation and broadcasts its termination
+		p.deactivated(hosted.ID)
+		delete(p.hosted, key)
+
+		return
+	}
+
 	found, key, meta := p.pidToMeta(msg.Who)
-	clusterKind := p.cluster.GetClusterKind(meta.ID.Kind)
-	clusterKind.Dec()
+	if !found {
+		return
+	}
+
+	// the activations hosted by other members are counted there
+	if meta.PID.Address == p.cluster.ActorSystem.Address() {
+		p.deactivated(meta.ID)
+	}
 
 	activationTerminated := &clustering.ActivationTerminated{
 		Pid:             msg.Who,
@@ -57,18 +86,28 @@ func (p *placementActor) onTerminated(msg *actor.Terminated) {
 	}
 	p.partitionManager.cluster.MemberList.BroadcastEvent(activationTerminated, true)
 
-	if found {
-		delete(p.actors, *key)
+	delete(p.actors, *key)
+}
+
+// deactivated counts the termination of an activation hosted by this member
+func (p *placementActor) deactivated(identity *clustering.ClusterIdentity) {
+	// the kind may have been unregistered since
+	if clusterKind, ok := p.cluster.TryGetClusterKind(identity.Kind); ok {
+		clusterKind.Dec()
 	}
 }
 
 func (p *placementActor) onStopping(ctx actor.Context) {
-	futures := make(map[string]*actor.Future, len(p.actors))
+	futures := make(map[string]*actor.Future, len(p.actors)+len(p.hosted))
 
 	for key, meta := range p.actors {
 		futures[key] = ctx.PoisonFuture(meta.PID)
 	}
 
+	for key, hosted := range p.hosted {
+		futures[key] = ctx.PoisonFuture(hosted.PID)
+	}
+
 	for key, future := range futures {
 		err := future.Wait()
 		if err != nil {
@@ -77,6 +116,8 @@ func (p *placementActor) onStopping(ctx actor.Context) {
 	}
 }
 
+// onActivationRequest activates an identity of a partition this member owns, on the member the member strategy of
+// the kind chooses or on this member
 func (p *placementActor) onActivationRequest(msg *clustering.ActivationRequest, ctx actor.Context) {
 	key := msg.ClusterIdentity.AsKey()
 	meta, found := p.actors[key]
@@ -88,19 +129,28 @@ func (p *placementActor) onActivationRequest(msg *clustering.ActivationRequest,
 		return
 	}
 
-	clusterKind := p.cluster.GetClusterKind(msg.ClusterIdentity.Kind)
-	if clusterKind == nil {
-		ctx.Logger().Error("Unknown cluster kind", slog.String("kind", msg.ClusterIdentity.Kind))
+	if future, ok := p.pending[key]; ok {
+		// the identity is being activated on another member
+		ctx.ReenterAfter(future, func(_ interface{}, _ error) {
+			p.respondActivation(key, ctx)
+		})
 
-		// TODO: what to do here?
-		ctx.Respond(nil)
 		return
 	}
 
-	props := clustering.WithClusterIdentity(clusterKind.Props, msg.ClusterIdentity)
+	activator := p.cluster.MemberList.GetIdentityActivatorMember(msg.ClusterIdentity)
+	if activator != "" && activator != p.cluster.ActorSystem.Address() {
+		p.activateOn(activator, msg.ClusterIdentity, ctx)
 
-	pid := ctx.SpawnPrefix(props, msg.ClusterIdentity.Identity)
-	clusterKind.Inc()
+		return
+	}
+
+	pid := p.spawn(msg.ClusterIdentity, ctx)
+	if pid == nil {
+		// TODO: what to do here?
+		ctx.Respond(nil)
+		return
+	}
 
 	p.actors[key] = GrainMeta{
 		ID:  msg.ClusterIdentity,
@@ -114,18 +164,106 @@ func (p *placementActor) onActivationRequest(msg *clustering.ActivationRequest,
 	ctx.Respond(response)
 }
 
+// activateOn asks the activator to host the activation, the activation is watched so that its termination is
+// broadcast like the one of the activations of this member
+func (p *placementActor) activateOn(activator string, identity *clustering.ClusterIdentity, ctx actor.Context) {
+	key := identity.AsKey()
+	request := &clustering.HostedActivationRequest{ClusterIdentity: identity}
+	future := ctx.RequestFuture(p.partitionManager.PidOfActivatorActor(activator), request, p.cluster.Config.RequestTimeoutTime)
+	p.pending[key] = future
+
+	ctx.ReenterAfter(future, func(res interface{}, err error) {
+		delete(p.pending, key)
+
+		if response, ok := res.(*clustering.ActivationResponse); ok && err == nil && response.Pid != nil {
+			ctx.Watch(response.Pid)
+			p.actors[key] = GrainMeta{
+				ID:  identity,
+				PID: response.Pid,
+			}
+		} else {
+			ctx.Logger().Error("Failed to activate identity", slog.String("identity", key), slog.String("activator", activator), slog.Any("error", err))
+		}
+
+		p.respondActivation(key, ctx)
+	})
+}
+
+func (p *placementActor) respondActivation(key string, ctx actor.Context) {
+	if meta, ok := p.actors[key]; ok {
+		ctx.Respond(&clustering.ActivationResponse{Pid: meta.PID})
+
+		return
+	}
+
+	ctx.Respond(&clustering.ActivationResponse{Failed: true})
+}
+
+// onHostedActivationRequest activates an identity of a partition the sender owns on this member
+func (p *placementActor) onHostedActivationRequest(msg *clustering.HostedActivationRequest, ctx actor.Context) {
+	key := msg.ClusterIdentity.AsKey()
+	if hosted, ok := p.hosted[key]; ok {
+		ctx.Respond(&clustering.ActivationResponse{Pid: hosted.PID})
+
+		return
+	}
+
+	pid := p.spawn(msg.ClusterIdentity, ctx)
+	if pid == nil {
+		ctx.Respond(&clustering.ActivationResponse{Failed: true})
+
+		return
+	}
+
+	p.hosted[key] = hostedActivation{
+		GrainMeta: GrainMeta{
+			ID:  msg.ClusterIdentity,
+			PID: pid,
+		},
+		owner: ctx.Sender().Address,
+	}
+
+	ctx.Respond(&clustering.ActivationResponse{Pid: pid})
+}
+
+// spawn activates the identity on this member, nil if the kind is not registered
+func (p *placementActor) spawn(identity *clustering.ClusterIdentity, ctx actor.Context) *actor.PID {
+	clusterKind := p.cluster.GetClusterKind(identity.Kind)
+	if clusterKind == nil {
+		ctx.Logger().Error("Unknown cluster kind", slog.String("kind", identity.Kind))
+
+		return nil
+	}
+
+	props := clustering.WithClusterIdentity(clusterKind.Props, identity)
+
+	pid := ctx.SpawnPrefix(props, identity.Identity)
+	clusterKind.Inc()
+
+	return pid
+}
+
 func (p *placementActor) pidToMeta(pid *actor.PID) (bool, *string, *GrainMeta) {
 	for k, v := range p.actors {
-		if v.PID == pid {
+		if v.PID.Equal(pid) {
 			return true, &k, &v
 		}
 	}
 	return false, nil, nil
 }
 
+func (p *placementActor) pidToHosted(pid *actor.PID) (string, hostedActivation, bool) {
+	for k, v := range p.hosted {
+		if v.PID.Equal(pid) {
+			return k, v, true
+		}
+	}
+
+	return "", hostedActivation{}, false
+}
+
 func (p *placementActor) onClusterTopology(msg *clustering.ClusterTopology, ctx actor.Context) {
-	rdv := clustering.NewRendezvous()
-	rdv.UpdateMembers(msg.Members)
+	rdv := p.cluster.NewRendezvous(msg.Members)
 	myAddress := p.cluster.ActorSystem.Address()
 	for identity, meta := range p.actors {
 		ownerAddress := rdv.GetByIdentity(identity)
@@ -139,4 +277,21 @@ func (p *placementActor) onClusterTopology(msg *clustering.ClusterTopology, ctx
 
 		ctx.Poison(meta.PID)
 	}
+
+	for identity, hosted := range p.hosted {
+		// the new owner of the partition does not know about the activation
+		if rdv.GetByIdentity(identity) != hosted.owner {
+			ctx.Logger().Debug("Hosted actor moved", slog.String("identity", identity), slog.String("owner", hosted.owner))
+
+			ctx.Poison(hosted.PID)
+		}
+	}
+
+	for _, member := range msg.Members {
+		if member.Id == p.cluster.ActorSystem.ID {
+			p.partitionManager.markReady()
+
+			break
+		}
+	}
 }
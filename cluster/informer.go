@@ -19,6 +19,7 @@ const (
 	TopologyKey       string = "topology"
 	HeartbeatKey      string = "heartbeat"
 	GracefullyLeftKey string = "left"
+	BlockListKey      string = "blocklist"
 )
 
 // create and seed a pseudo random numbers generator
@@ -30,7 +31,7 @@ type Informer struct {
 	localSeqNumber    int64
 	state             *GossipState
 	committedOffsets  map[string]int64
-	activeMemberIDs   map[string]empty
+	activeMemberIDs   map[string]empty // the members of the current topology, the consensus checks require all of them to agree
 	otherMembers      []*Member
 	consensusChecks   *ConsensusChecks
 	getBlockedMembers func() set.Set[string]
@@ -78,6 +79,7 @@ func (inf *Informer) UpdateClusterTopology(topology *ClusterTopology) {
 	for _, member := range topology.Members {
 		active[member.Id] = empty{}
 	}
+	inf.activeMemberIDs = active
 
 	inf.SetState(TopologyKey, topology)
 }
@@ -1,12 +1,15 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"sync"
 	"testing"
 
 	"github.com/asynkron/gofun/set"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -209,3 +212,72 @@ func TestInformer_GetMemberStateDelta(t *testing.T) {
 		t.Error("member state delta is nil")
 	}
 }
+
+func TestInformer_TopologyConsensus(t *testing.T) {
+	t.Parallel()
+
+	a := func() set.Set[string] {
+		return set.New[string]()
+	}
+	topologyHash := func(any *anypb.Any) interface{} {
+		var topology ClusterTopology
+		if err := any.UnmarshalTo(&topology); err != nil {
+			return nil
+		}
+
+		return topology.TopologyHash
+	}
+	topology := func(hash uint64, ids ...string) *ClusterTopology {
+		members := make([]*Member, 0, len(ids))
+		for _, id := range ids {
+			members = append(members, &Member{Id: id, Host: id, Port: 123})
+		}
+
+		return &ClusterTopology{TopologyHash: hash, Members: members}
+	}
+
+	member1 := newInformer("member1", a, 3, 3, slog.Default())
+	member2 := newInformer("member2", a, 3, 3, slog.Default())
+	member3 := newInformer("member3", a, 3, 3, slog.Default())
+
+	handler, check := NewConsensusCheckBuilder(slog.Default(), TopologyKey, topologyHash).Build()
+	member1.AddConsensusCheck(handler.GetID(), check)
+
+	// member2 has not gossiped its topology yet
+	member1.UpdateClusterTopology(topology(1, "member1", "member2"))
+	_, ok := handler.TryGetConsensus(context.Background())
+	assert.False(t, ok)
+
+	member2.UpdateClusterTopology(topology(1, "member1", "member2"))
+	member1.ReceiveState(proto.Clone(member2.state).(*GossipState))
+	value, ok := handler.TryGetConsensus(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), value)
+
+	// members outside of the topology do not take part in the consensus
+	member3.UpdateClusterTopology(topology(3, "member3"))
+	member1.ReceiveState(proto.Clone(member3.state).(*GossipState))
+	value, ok = handler.TryGetConsensus(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), value)
+
+	// the consensus is lost until all the members agree on the new topology
+	member1.UpdateClusterTopology(topology(2, "member1", "member2"))
+	_, ok = handler.TryGetConsensus(context.Background())
+	assert.False(t, ok)
+
+	member2.UpdateClusterTopology(topology(2, "member1", "member2"))
+	member1.ReceiveState(proto.Clone(member2.state).(*GossipState))
+	value, ok = handler.TryGetConsensus(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), value)
+
+	// the consensus follows the members agreeing on another topology at once
+	state := proto.Clone(member1.state).(*GossipState)
+	setKey(state, TopologyKey, topology(4, "member1", "member2"), "member1", 100)
+	setKey(state, TopologyKey, topology(4, "member1", "member2"), "member2", 100)
+	check.check(state, member1.activeMemberIDs)
+	value, ok = handler.TryGetConsensus(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(4), value)
+}
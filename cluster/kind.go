@@ -1,16 +1,27 @@
 package cluster
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
 	"sync/atomic"
 
 	"github.com/asynkron/protoactor-go/actor"
 )
 
+var (
+	ErrKindAlreadyRegistered = errors.New("cluster: kind already registered")
+	ErrKindNotRegistered     = errors.New("cluster: kind not registered")
+)
+
 // Kind represents the kinds of actors a cluster can manage
 type Kind struct {
 	Kind            string
 	Props           *actor.Props
 	StrategyBuilder func(*Cluster) MemberStrategy
+	Placement       *KindPlacement // the constraints the grains of the kind are placed with, nil places them on any member
 }
 
 // NewKind creates a new instance of a kind
@@ -28,6 +39,17 @@ func (k *Kind) WithMemberStrategy(strategyBuilder func(*Cluster) MemberStrategy)
 	k.StrategyBuilder = strategyBuilder
 }
 
+// WithPlacement constrains the members the grains of the kind are placed on
+func (k *Kind) WithPlacement(opts ...PlacementOption) {
+	if k.Placement == nil {
+		k.Placement = &KindPlacement{}
+	}
+
+	for _, opt := range opts {
+		opt(k.Placement)
+	}
+}
+
 func (k *Kind) Build(cluster *Cluster) *ActivatedKind {
 	var strategy MemberStrategy = nil
 	if k.StrategyBuilder != nil {
@@ -35,17 +57,20 @@ func (k *Kind) Build(cluster *Cluster) *ActivatedKind {
 	}
 
 	return &ActivatedKind{
-		Kind:     k.Kind,
-		Props:    k.Props,
-		Strategy: strategy,
+		Kind:      k.Kind,
+		Props:     k.Props,
+		Strategy:  strategy,
+		Placement: k.Placement,
 	}
 }
 
 type ActivatedKind struct {
-	Kind     string
-	Props    *actor.Props
-	Strategy MemberStrategy
-	count    int32
+	Kind      string
+	Props     *actor.Props
+	Strategy  MemberStrategy
+	Placement *KindPlacement
+	count     int32
+	pids      sync.Map // the activations on the local member
 }
 
 func (ak *ActivatedKind) Inc() {
@@ -59,3 +84,126 @@ func (ak *ActivatedKind) Dec() {
 func (ak *ActivatedKind) Count() int32 {
 	return atomic.LoadInt32(&ak.count)
 }
+
+func (ak *ActivatedKind) activated(pid *actor.PID) {
+	ak.pids.Store(pid.Id, pid)
+}
+
+func (ak *ActivatedKind) deactivated(pid *actor.PID) {
+	ak.pids.Delete(pid.Id)
+}
+
+// MailboxLength returns the number of user messages waiting in the mailboxes of the activations on the local member
+func (ak *ActivatedKind) MailboxLength(system *actor.ActorSystem) int64 {
+	var length int64
+
+	ak.pids.Range(func(_, value any) bool {
+		process, ok := system.ProcessRegistry.Get(value.(*actor.PID))
+		if mailbox, isActor := process.(interface{ UserMessageCount() int }); ok && isActor {
+			length += int64(mailbox.UserMessageCount())
+		}
+
+		return true
+	})
+
+	return length
+}
+
+// RegisterKind starts hosting the kind on the local member after StartMember. The kind is advertised to the other
+// members through gossip and through the ClusterProvider when it implements KindsUpdatingProvider, the grains of the
+// kind are placed on this member once they updated their topology.
+func (c *Cluster) RegisterKind(kind *Kind) error {
+	if c.leaderElection == nil {
+		return ErrMemberNotStarted
+	}
+
+	activated := kind.Build(c)
+
+	c.kindsMutex.Lock()
+	if _, ok := c.kinds[kind.Kind]; ok {
+		c.kindsMutex.Unlock()
+
+		return fmt.Errorf("%w: %s", ErrKindAlreadyRegistered, kind.Kind)
+	}
+	c.kinds[kind.Kind] = activated
+	c.kindsMutex.Unlock()
+
+	c.Logger().Info("Registered cluster kind", slog.String("kind", kind.Kind))
+
+	return c.advertiseKinds()
+}
+
+// UnregisterKind stops hosting the kind on the local member. No grains of the kind are activated on this member
+// anymore, its kind is withdrawn from the other members and its activations are stopped, so that they are activated
+// on the members still hosting the kind. It returns once the activations stopped or the context is done.
+func (c *Cluster) UnregisterKind(ctx context.Context, name string) error {
+	if c.leaderElection == nil {
+		return ErrMemberNotStarted
+	}
+
+	if name == TopicActorKind {
+		return fmt.Errorf("cluster: the %s kind is required by pubsub", TopicActorKind)
+	}
+
+	c.kindsMutex.Lock()
+	kind, ok := c.kinds[name]
+	delete(c.kinds, name)
+	c.kindsMutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrKindNotRegistered, name)
+	}
+
+	c.Logger().Info("Unregistered cluster kind", slog.String("kind", name))
+
+	if err := c.advertiseKinds(); err != nil {
+		return err
+	}
+
+	return c.drainKind(ctx, kind)
+}
+
+// advertiseKinds publishes the kinds of the local member after they changed
+func (c *Cluster) advertiseKinds() error {
+	c.gossipPlacement()
+
+	if provider, ok := c.Config.ClusterProvider.(KindsUpdatingProvider); ok {
+		if err := provider.UpdateKinds(c.GetClusterKinds()); err != nil {
+			return fmt.Errorf("cluster: failed to update the kinds of the cluster provider: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// drainKind poisons the activations of the kind on the local member and waits for them to stop
+func (c *Cluster) drainKind(ctx context.Context, kind *ActivatedKind) error {
+	var futures []*actor.Future
+
+	kind.pids.Range(func(_, value any) bool {
+		futures = append(futures, c.ActorSystem.Root.PoisonFuture(value.(*actor.PID)))
+
+		return true
+	})
+
+	done := make(chan error, 1)
+
+	go func() {
+		for _, f := range futures {
+			// the activation may have stopped on its own in the meantime
+			if err := f.Wait(); err != nil && !errors.Is(err, actor.ErrDeadLetter) {
+				done <- fmt.Errorf("cluster: failed to drain the %s kind: %w", kind.Kind, err)
+
+				return
+			}
+		}
+		done <- nil
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		return err
+	}
+}
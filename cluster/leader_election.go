@@ -0,0 +1,159 @@
+package cluster
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LeaderKey is the gossip key under which every member publishes the member it votes for as leader
+const LeaderKey string = "leader"
+
+// LeaderElected is published on the EventStream when all the members agreed on a new leader
+type LeaderElected struct {
+	Leader   *Member
+	IsLeader bool // true on the elected member
+}
+
+// leaderElection elects the oldest member of the topology as leader. Every member gossips its vote, the leader is
+// elected once the votes of all the members reached consensus, so it works with every ClusterProvider.
+type leaderElection struct {
+	cluster   *Cluster
+	consensus ConsensusHandler
+	close     chan struct{}
+	vote      string
+
+	mu     sync.RWMutex
+	leader *Member
+}
+
+func newLeaderElection(cluster *Cluster) *leaderElection {
+	return &leaderElection{
+		cluster: cluster,
+		close:   make(chan struct{}),
+	}
+}
+
+func (le *leaderElection) start() {
+	le.consensus = le.cluster.Gossip.RegisterConsensusCheck(LeaderKey, func(any *anypb.Any) interface{} {
+		var vote ClusterTopologyNotification
+		if err := any.UnmarshalTo(&vote); err != nil {
+			le.cluster.Logger().Error("could not unpack leader vote", slog.Any("error", err))
+
+			return uint64(0)
+		}
+
+		return leaderHash(vote.LeaderId)
+	})
+
+	go func() {
+		ticker := time.NewTicker(le.cluster.Config.GossipInterval)
+		defer ticker.Stop()
+
+		for !le.cluster.ActorSystem.IsStopped() {
+			select {
+			case <-le.close:
+				return
+			case <-ticker.C:
+				le.update()
+			}
+		}
+	}()
+}
+
+func (le *leaderElection) stop() {
+	close(le.close)
+}
+
+// Leader returns the elected leader, nil while the members did not agree on one
+func (le *leaderElection) Leader() *Member {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+
+	return le.leader
+}
+
+func (le *leaderElection) update() {
+	members := le.cluster.MemberList.Members()
+
+	startedAt, err := le.cluster.Gossip.GetState(StartedAtKey)
+	if err != nil {
+		return
+	}
+
+	ages := make(map[string]time.Time, len(startedAt))
+	for id, kv := range startedAt {
+		var ts timestamppb.Timestamp
+		if err := kv.Value.UnmarshalTo(&ts); err == nil {
+			ages[id] = ts.AsTime()
+		}
+	}
+
+	if candidate := oldest(members.Members(), ages); candidate != nil && candidate.Id != le.vote {
+		le.vote = candidate.Id
+		le.cluster.Gossip.SetState(LeaderKey, &ClusterTopologyNotification{
+			MemberId:     le.cluster.ActorSystem.ID,
+			TopologyHash: uint32(members.TopologyHash()),
+			LeaderId:     candidate.Id,
+		})
+	}
+
+	var leader *Member
+	if value, ok := le.consensus.TryGetConsensus(context.Background()); ok {
+		hash, _ := value.(uint64)
+		for _, m := range members.Members() {
+			if leaderHash(m.Id) == hash {
+				leader = m
+				break
+			}
+		}
+	}
+
+	le.mu.Lock()
+	previous := le.leader
+	if leader != nil || (previous != nil && !members.ContainsID(previous.Id)) {
+		// keeps the leader while the members disagree, unless it is gone
+		le.leader = leader
+	}
+	le.mu.Unlock()
+
+	if leader != nil && (previous == nil || previous.Id != leader.Id) {
+		isLeader := leader.Id == le.cluster.ActorSystem.ID
+		le.cluster.Logger().Info("Leader elected", slog.String("leader", leader.Id), slog.Bool("isLeader", isLeader))
+		le.cluster.ActorSystem.EventStream.Publish(&LeaderElected{Leader: leader, IsLeader: isLeader})
+	}
+
+	le.cluster.singletons.update(le.Leader())
+}
+
+// Leader returns the leader the members agreed on, nil while they did not agree on one
+func (c *Cluster) Leader() *Member {
+	if c.leaderElection == nil {
+		return nil
+	}
+
+	return c.leaderElection.Leader()
+}
+
+// IsLeader returns true if the local member is the leader the members agreed on
+func (c *Cluster) IsLeader() bool {
+	leader := c.Leader()
+
+	return leader != nil && leader.Id == c.ActorSystem.ID
+}
+
+func leaderHash(memberID string) uint64 {
+	if memberID == "" {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(memberID))
+
+	return h.Sum64()
+}
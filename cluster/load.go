@@ -0,0 +1,138 @@
+package cluster
+
+import (
+	"log/slog"
+	"maps"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/eventstream"
+)
+
+// MemberLoad is the load a member gossips with its heartbeat
+type MemberLoad struct {
+	ActorCount    map[string]int64 // the activations of the kinds
+	MailboxLength int64            // the user messages waiting in the mailboxes of the activations
+	CPUUsage      float64          // between 0 and 1
+	UpdatedAt     time.Time        // when the local member received the load
+}
+
+// Activations returns the number of activations of all the kinds
+func (l MemberLoad) Activations() int64 {
+	var activations int64
+	for _, count := range l.ActorCount {
+		activations += count
+	}
+
+	return activations
+}
+
+// MemberLoads returns the last load gossiped by the members, keyed by member ID
+func (c *Cluster) MemberLoads() map[string]MemberLoad {
+	return c.loads.get()
+}
+
+// memberLoads keeps the loads of the members, they are updated with the heartbeats
+type memberLoads struct {
+	mu          sync.RWMutex
+	loads       map[string]MemberLoad
+	eventStream *eventstream.EventStream
+	sub         *eventstream.Subscription
+}
+
+func newMemberLoads(cluster *Cluster) *memberLoads {
+	loads := &memberLoads{loads: make(map[string]MemberLoad), eventStream: cluster.ActorSystem.EventStream}
+
+	loads.sub = cluster.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		switch t := evt.(type) {
+		case *GossipUpdate:
+			if t.Key != HeartbeatKey {
+				break
+			}
+
+			var heartbeat MemberHeartbeat
+			if err := t.Value.UnmarshalTo(&heartbeat); err != nil {
+				cluster.Logger().Warn("could not unpack into MemberHeartbeat proto.Message form Any", slog.Any("error", err))
+
+				break
+			}
+
+			loads.update(t.MemberID, &heartbeat)
+		case *ClusterTopology:
+			for _, m := range t.Left {
+				loads.remove(m.Id)
+			}
+		}
+	})
+
+	return loads
+}
+
+func (ml *memberLoads) unsubscribe() {
+	ml.eventStream.Unsubscribe(ml.sub)
+}
+
+func (ml *memberLoads) update(memberID string, heartbeat *MemberHeartbeat) {
+	load := MemberLoad{
+		ActorCount:    heartbeat.GetActorStatistics().GetActorCount(),
+		MailboxLength: heartbeat.GetActorStatistics().GetMailboxLength(),
+		CPUUsage:      heartbeat.CpuUsage,
+		UpdatedAt:     time.Now(),
+	}
+
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	ml.loads[memberID] = load
+}
+
+func (ml *memberLoads) remove(memberID string) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	delete(ml.loads, memberID)
+}
+
+func (ml *memberLoads) get() map[string]MemberLoad {
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+
+	return maps.Clone(ml.loads)
+}
+
+// cpuSampler measures the CPU time the process used since the previous sample, as a share of the capacity of
+// GOMAXPROCS over the same wall time
+type cpuSampler struct {
+	mu      sync.Mutex
+	cpuTime time.Duration
+	at      time.Time
+}
+
+func newCPUSampler() *cpuSampler {
+	s := &cpuSampler{}
+	s.cpuTime, _ = processCPUTime()
+	s.at = time.Now()
+
+	return s
+}
+
+func (s *cpuSampler) Usage() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cpuTime, ok := processCPUTime()
+	if !ok {
+		return 0
+	}
+
+	now := time.Now()
+	deltaCPU, deltaWall := cpuTime-s.cpuTime, now.Sub(s.at)
+	s.cpuTime, s.at = cpuTime, now
+
+	if deltaWall <= 0 {
+		return 0
+	}
+
+	return min(max(float64(deltaCPU)/(float64(deltaWall)*float64(runtime.GOMAXPROCS(0))), 0), 1)
+}
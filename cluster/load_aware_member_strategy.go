@@ -0,0 +1,188 @@
+package cluster
+
+import (
+	"sync"
+	"time"
+)
+
+// LoadAwareOption configures the load aware member strategy
+type LoadAwareOption func(strategy *loadAwareMemberStrategy)
+
+// WithLoadFactor sets how many times the average load of the members of the kind a member may reach before it
+// stops receiving new activations. Default is 1.25.
+func WithLoadFactor(factor float64) LoadAwareOption {
+	return func(strategy *loadAwareMemberStrategy) {
+		strategy.factor = factor
+	}
+}
+
+// WithLoadScore sets how the load of a member is scored for the kind. Default is the activations of the kind plus
+// the messages waiting in the mailboxes of the member, scaled up by its CPU usage.
+func WithLoadScore(score func(kind string, load MemberLoad) float64) LoadAwareOption {
+	return func(strategy *loadAwareMemberStrategy) {
+		strategy.score = score
+	}
+}
+
+func defaultLoadScore(kind string, load MemberLoad) float64 {
+	return (float64(load.ActorCount[kind]+load.MailboxLength) + 1) * (1 + load.CPUUsage)
+}
+
+// NewLoadAwareMemberStrategy returns a builder for Config.MemberStrategyBuilder of a MemberStrategy taking the load
+// the members gossip with their heartbeats into account. The partitions are owned by rendezvous hashing, so that all
+// members agree on the owner of an identity whatever load they observed. The owner activates the identity with
+// bounded-load consistent hashing: on the first member in the rendezvous order of the identity that is not loaded more
+// than the load factor times the average, so that hot members stop receiving new activations.
+func NewLoadAwareMemberStrategy(opts ...LoadAwareOption) func(cluster *Cluster, kind string) MemberStrategy {
+	return func(cluster *Cluster, kind string) MemberStrategy {
+		ms := &loadAwareMemberStrategy{
+			cluster:  cluster,
+			kind:     kind,
+			factor:   1.25,
+			score:    defaultLoadScore,
+			members:  make(Members, 0),
+			rdv:      NewRendezvous(),
+			assigned: make(map[string]assignment),
+		}
+
+		for _, opt := range opts {
+			opt(ms)
+		}
+
+		return ms
+	}
+}
+
+// assignment counts the activations assigned to a member since its load was last gossiped
+type assignment struct {
+	count int
+	since time.Time
+}
+
+var _ IdentityActivatorStrategy = &loadAwareMemberStrategy{}
+
+type loadAwareMemberStrategy struct {
+	cluster *Cluster
+	kind    string
+	factor  float64
+	score   func(kind string, load MemberLoad) float64
+	members Members
+	rdv     *Rendezvous
+
+	mu       sync.Mutex
+	assigned map[string]assignment
+}
+
+func (m *loadAwareMemberStrategy) AddMember(member *Member) {
+	m.members = append(m.members, member)
+	m.rdv.UpdateMembers(m.members)
+	m.rdv.SetPlacements(m.cluster.KindPlacements())
+}
+
+func (m *loadAwareMemberStrategy) RemoveMember(member *Member) {
+	for i, mb := range m.members {
+		if mb.Address() == member.Address() {
+			m.members = append(m.members[:i], m.members[i+1:]...)
+			m.rdv.UpdateMembers(m.members)
+			m.rdv.SetPlacements(m.cluster.KindPlacements())
+
+			m.mu.Lock()
+			delete(m.assigned, member.Id)
+			m.mu.Unlock()
+
+			return
+		}
+	}
+}
+
+func (m *loadAwareMemberStrategy) GetAllMembers() Members {
+	return m.members
+}
+
+// GetPartition returns the owner of the partition of the key, it only depends on the topology
+func (m *loadAwareMemberStrategy) GetPartition(key string) string {
+	return m.rdv.GetByClusterIdentity(&ClusterIdentity{Kind: m.kind, Identity: key})
+}
+
+// GetIdentityActivator returns the first member in the rendezvous order of the identity not loaded more than the load
+// factor times the average, the first member if all of them are
+func (m *loadAwareMemberStrategy) GetIdentityActivator(identity string) string {
+	ranked := m.rdv.Rank(&ClusterIdentity{Kind: m.kind, Identity: identity})
+	if len(ranked) == 0 {
+		return ""
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loads := m.cluster.MemberLoads()
+	scores := make([]float64, len(ranked))
+
+	var total float64
+	for i, member := range ranked {
+		scores[i] = m.load(member, loads)
+		total += scores[i]
+	}
+
+	activator := ranked[0]
+
+	bound := m.factor * total / float64(len(ranked))
+	for i, member := range ranked {
+		if scores[i] <= bound {
+			activator = member
+
+			break
+		}
+	}
+
+	m.assign(activator)
+
+	return activator.Address()
+}
+
+// GetActivator returns the least loaded member, the ties are broken by the rendezvous order of the sender
+func (m *loadAwareMemberStrategy) GetActivator(senderAddress string) string {
+	ranked := m.rdv.Rank(&ClusterIdentity{Kind: m.kind, Identity: senderAddress})
+	if len(ranked) == 0 {
+		return ""
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loads := m.cluster.MemberLoads()
+
+	var activator *Member
+	var lowest float64
+	for _, member := range ranked {
+		if score := m.load(member, loads); activator == nil || score < lowest {
+			activator = member
+			lowest = score
+		}
+	}
+
+	m.assign(activator)
+
+	return activator.Address()
+}
+
+// assign counts an activation of the member until its load includes it. The caller holds the lock.
+func (m *loadAwareMemberStrategy) assign(member *Member) {
+	a := m.assigned[member.Id]
+	a.count++
+	m.assigned[member.Id] = a
+}
+
+// load returns the score of the gossiped load of the member plus the activations assigned to it since. The caller
+// holds the lock.
+func (m *loadAwareMemberStrategy) load(member *Member, loads map[string]MemberLoad) float64 {
+	load := loads[member.Id]
+
+	a := m.assigned[member.Id]
+	if load.UpdatedAt.After(a.since) {
+		a = assignment{since: load.UpdatedAt}
+		m.assigned[member.Id] = a
+	}
+
+	return m.score(m.kind, load) + float64(a.count)
+}
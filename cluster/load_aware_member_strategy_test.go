@@ -0,0 +1,156 @@
+package cluster
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLoadAwareMemberStrategyForTest(t *testing.T, count int, opts ...LoadAwareOption) (*Cluster, IdentityActivatorStrategy, Members) {
+	t.Helper()
+
+	c := newClusterForTest(t.Name(), nil)
+	ms := NewLoadAwareMemberStrategy(opts...)(c, "kind").(IdentityActivatorStrategy)
+
+	members := newMembersForTest(count)
+	for _, m := range members {
+		ms.AddMember(m)
+	}
+
+	return c, ms, members
+}
+
+func heartbeatForTest(activations int64) *MemberHeartbeat {
+	return &MemberHeartbeat{ActorStatistics: &ActorStatistics{ActorCount: map[string]int64{"kind": activations}}}
+}
+
+func TestLoadAwareMemberStrategy_GetActivator(t *testing.T) {
+	c, ms, members := newLoadAwareMemberStrategyForTest(t, 3)
+
+	c.loads.update(members[0].Id, heartbeatForTest(10))
+	c.loads.update(members[1].Id, heartbeatForTest(2))
+	c.loads.update(members[2].Id, heartbeatForTest(5))
+
+	assert.Equal(t, members[1].Address(), ms.GetActivator("sender"))
+
+	// the activations assigned to the member count until its load is gossiped again
+	activators := make(map[string]int)
+	for i := 0; i < 6; i++ {
+		activators[ms.GetActivator("sender")]++
+	}
+
+	assert.Equal(t, 4, activators[members[1].Address()])
+	assert.Equal(t, 2, activators[members[2].Address()])
+	assert.Zero(t, activators[members[0].Address()])
+
+	c.loads.update(members[1].Id, heartbeatForTest(2))
+	assert.Equal(t, members[1].Address(), ms.GetActivator("sender"))
+}
+
+func TestLoadAwareMemberStrategy_GetPartition(t *testing.T) {
+	// two members observing different loads
+	c1, ms1, members := newLoadAwareMemberStrategyForTest(t, 3)
+	c2, ms2, _ := newLoadAwareMemberStrategyForTest(t, 3)
+
+	c1.loads.update(members[0].Id, heartbeatForTest(100))
+	c2.loads.update(members[1].Id, heartbeatForTest(100))
+
+	rdv := NewRendezvous()
+	rdv.UpdateMembers(members)
+
+	// the members agree on the owners of the partitions, they are placed by rendezvous hashing whatever the load
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("identity-%d", i)
+		owner := ms1.GetPartition(key)
+
+		assert.Equal(t, rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "kind", Identity: key}), owner)
+		assert.Equal(t, owner, ms2.GetPartition(key))
+	}
+}
+
+func TestLoadAwareMemberStrategy_GetIdentityActivator(t *testing.T) {
+	c, ms, members := newLoadAwareMemberStrategyForTest(t, 4)
+
+	rdv := NewRendezvous()
+	rdv.UpdateMembers(members)
+
+	// without load the identities are activated on the owners of their partitions
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("identity-%d", i)
+		assert.Equal(t, rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "kind", Identity: key}), ms.GetIdentityActivator(key))
+	}
+
+	// hot members stop receiving activations
+	c.loads.update(members[0].Id, heartbeatForTest(100))
+	c.loads.update(members[1].Id, heartbeatForTest(10))
+	c.loads.update(members[2].Id, heartbeatForTest(10))
+	c.loads.update(members[3].Id, heartbeatForTest(10))
+
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("identity-%d", i)
+		activator := ms.GetIdentityActivator(key)
+		assert.NotEqual(t, members[0].Address(), activator)
+
+		if expected := rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "kind", Identity: key}); expected != members[0].Address() {
+			assert.Equal(t, expected, activator, "the activations of the other members do not move")
+		}
+	}
+}
+
+func TestLoadAwareMemberStrategy_LoadFactor(t *testing.T) {
+	c, ms, members := newLoadAwareMemberStrategyForTest(t, 2, WithLoadFactor(100))
+
+	c.loads.update(members[0].Id, heartbeatForTest(100))
+	c.loads.update(members[1].Id, heartbeatForTest(0))
+
+	activators := make(map[string]struct{})
+	for i := 0; i < 100; i++ {
+		activators[ms.GetIdentityActivator(fmt.Sprintf("identity-%d", i))] = struct{}{}
+	}
+
+	assert.Len(t, activators, 2)
+}
+
+func TestActivatedKind_MailboxLength(t *testing.T) {
+	system := actor.NewActorSystem()
+	kind := NewKind("kind", actor.PropsFromFunc(func(ctx actor.Context) {})).Build(nil)
+
+	block := make(chan struct{})
+	defer close(block)
+
+	pid := system.Root.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(string); ok {
+			<-block
+		}
+	}))
+	kind.activated(pid)
+
+	for i := 0; i < 4; i++ {
+		system.Root.Send(pid, "message")
+	}
+
+	assert.Eventually(t, func() bool {
+		return kind.MailboxLength(system) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	kind.deactivated(pid)
+	assert.Zero(t, kind.MailboxLength(system))
+}
+
+func TestCPUSampler_Usage(t *testing.T) {
+	sampler := newCPUSampler()
+	sampler.Usage()
+
+	// a busy loop that does not allocate, the usage must not depend on garbage collections
+	x := 0
+	for start := time.Now(); time.Since(start) < 100*time.Millisecond; {
+		x++
+	}
+
+	usage := sampler.Usage()
+	assert.Greater(t, usage, 0.0)
+	assert.LessOrEqual(t, usage, 1.0)
+}
@@ -3,6 +3,7 @@ package cluster
 import (
 	"context"
 	"log/slog"
+	"maps"
 	"sync"
 
 	"github.com/asynkron/protoactor-go/actor"
@@ -18,9 +19,17 @@ type MemberList struct {
 	cluster              *Cluster
 	mutex                sync.RWMutex
 	members              *MemberSet
+	providerMembers      Members // the members last reported by the ClusterProvider, blocked ones included
 	memberStrategyByKind map[string]MemberStrategy
+	placementChanged     bool // the labels, kinds or kind placements changed since the topology was last published
+
+	placementMutex sync.RWMutex
+	labels         map[string]map[string]string // the gossiped labels, keyed by member ID
+	kinds          map[string][]string          // the gossiped kinds, keyed by member ID
+	placements     map[string]*KindPlacement    // the gossiped kind placements, keyed by kind
 
 	eventSteam        *eventstream.EventStream
+	membershipSub     *eventstream.Subscription
 	topologyConsensus ConsensusHandler
 }
 
@@ -29,25 +38,49 @@ func NewMemberList(cluster *Cluster) *MemberList {
 		cluster:              cluster,
 		members:              emptyMemberSet,
 		memberStrategyByKind: make(map[string]MemberStrategy),
+		labels:               make(map[string]map[string]string),
+		kinds:                make(map[string][]string),
+		placements:           make(map[string]*KindPlacement),
 		eventSteam:           cluster.ActorSystem.EventStream,
 	}
-	memberList.eventSteam.Subscribe(func(evt interface{}) {
+	memberList.membershipSub = memberList.eventSteam.Subscribe(func(evt interface{}) {
 		switch t := evt.(type) {
 		case *GossipUpdate:
-			if t.Key != "topology" {
-				break
+			switch t.Key {
+			case BlockListKey:
+				// the blocked members of the peers are merged from their block list, the Blocked members of their
+				// topology lack the reason, ttl and unblocks and would block members again forever
+				if t.MemberID == cluster.ActorSystem.ID {
+					break
+				}
+
+				var state BlockListState
+				if err := t.Value.UnmarshalTo(&state); err != nil {
+					cluster.Logger().Warn("could not unpack into BlockListState proto.Message form Any", slog.Any("error", err))
+
+					break
+				}
+
+				blockList := memberList.cluster.Remote.BlockList()
+				version := blockList.Version()
+
+				if unblocked := state.mergeInto(blockList); len(unblocked) > 0 {
+					cluster.Logger().Info("Members unblocked by gossip", slog.String("from", t.MemberID), slog.Any("members", unblocked))
+				}
+
+				if blockList.Version() != version {
+					memberList.refreshTopology()
+				}
+			case PlacementKey:
+				var placement MemberPlacement
+				if err := t.Value.UnmarshalTo(&placement); err != nil {
+					cluster.Logger().Warn("could not unpack into MemberPlacement proto.Message form Any", slog.Any("error", err))
+
+					break
+				}
+
+				memberList.updatePlacement(t.MemberID, &placement)
 			}
-
-			// get blocked members from all other member states
-			// and merge that without own blocked set
-			var topology ClusterTopology
-			if err := t.Value.UnmarshalTo(&topology); err != nil {
-				cluster.Logger().Warn("could not unpack into ClusterTopology proto.Message form Any", slog.Any("error", err))
-
-				break
-			}
-			blocked := topology.Blocked
-			memberList.cluster.Remote.BlockList().Block(blocked...)
 		}
 	})
 
@@ -55,7 +88,7 @@ func NewMemberList(cluster *Cluster) *MemberList {
 }
 
 func (ml *MemberList) stopMemberList() {
-	// ml.cluster.ActorSystem.EventStream.Unsubscribe(ml.membershipSub)
+	ml.eventSteam.Unsubscribe(ml.membershipSub)
 }
 
 func (ml *MemberList) InitializeTopologyConsensus() {
@@ -117,11 +150,30 @@ func (ml *MemberList) GetActivatorMember(kind string, requestSourceAddress strin
 	return res
 }
 
+// GetIdentityActivatorMember returns the member activating the identity on behalf of the owner of its partition, empty
+// if the member strategy of the kind does not choose one and the owner activates the identity itself
+func (ml *MemberList) GetIdentityActivatorMember(clusterIdentity *ClusterIdentity) string {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	if ms, ok := ml.memberStrategyByKind[clusterIdentity.Kind].(IdentityActivatorStrategy); ok {
+		return ms.GetIdentityActivator(clusterIdentity.Identity)
+	}
+
+	return ""
+}
+
 func (ml *MemberList) Length() int {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
 	return ml.members.Len()
 }
 
 func (ml *MemberList) Members() *MemberSet {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
 	return ml.members
 }
 
@@ -129,19 +181,36 @@ func (ml *MemberList) UpdateClusterTopology(members Members) {
 	ml.mutex.Lock()
 	defer ml.mutex.Unlock()
 
+	ml.providerMembers = members
+	ml.updateClusterTopology(members)
+}
+
+// updateClusterTopology applies the members reported by the ClusterProvider. The caller holds the lock.
+func (ml *MemberList) updateClusterTopology(members Members) {
 	// TLDR:
 	// this method basically filters out any member status in the blocked list
 	// then makes a delta between new and old members
 	// notifying the cluster accordingly which members left or joined
 
+	members = ml.withPlacement(members)
+
 	topology, done, active, joined, left := ml.getTopologyChanges(members)
 	if done {
 		return
 	}
 
+	ml.placementChanged = false
+	changed := ml.changedMembers(active)
+
 	// include any new blocked members into the known set of blocked members
 	for _, m := range left.Members() {
-		ml.cluster.Remote.BlockList().Block(m.Id)
+		ml.cluster.block(BlockReasonLeftTopology, m.Id)
+	}
+
+	// replace the members whose labels or kinds changed in the member strategies
+	for _, m := range changed {
+		ml.memberLeave(ml.members.GetMemberById(m.Id))
+		ml.memberJoin(m)
 	}
 
 	ml.members = active
@@ -168,6 +237,17 @@ func (ml *MemberList) UpdateClusterTopology(members Members) {
 		slog.Int("membersFromProvider", len(members)))
 }
 
+// refreshTopology updates the topology from the members last reported by the ClusterProvider, e.g. after members
+// were blocked or unblocked
+func (ml *MemberList) refreshTopology() {
+	ml.mutex.Lock()
+	defer ml.mutex.Unlock()
+
+	if ml.providerMembers != nil {
+		ml.updateClusterTopology(ml.providerMembers)
+	}
+}
+
 func (ml *MemberList) memberJoin(joiningMember *Member) {
 	ml.cluster.Logger().Info("member joined", slog.String("member", joiningMember.Id))
 
@@ -200,7 +280,7 @@ func (ml *MemberList) getTopologyChanges(members Members) (topology *ClusterTopo
 	active = memberSet.ExceptIds(blocked)
 
 	// nothing changed? exit
-	if active.Equals(ml.members) {
+	if active.Equals(ml.members) && len(ml.changedMembers(active)) == 0 && !ml.placementChanged {
 		return nil, true, nil, nil, nil
 	}
 
@@ -217,6 +297,20 @@ func (ml *MemberList) getTopologyChanges(members Members) (topology *ClusterTopo
 	return topology, false, active, joined, left
 }
 
+// changedMembers returns the active members whose labels or kinds differ from the ones they were added with
+func (ml *MemberList) changedMembers(active *MemberSet) Members {
+	var changed Members
+
+	for _, m := range active.Members() {
+		current := ml.members.GetMemberById(m.Id)
+		if current != nil && (!maps.Equal(current.Labels, m.Labels) || !sameKinds(current.Kinds, m.Kinds)) {
+			changed = append(changed, m)
+		}
+	}
+
+	return changed
+}
+
 func (ml *MemberList) TerminateMember(m *Member) {
 	// tell the world that this endpoint should is no longer relevant
 	ml.cluster.ActorSystem.EventStream.Publish(&remote.EndpointTerminatedEvent{
@@ -236,6 +330,9 @@ func (ml *MemberList) BroadcastEvent(message interface{}, includeSelf bool) {
 }
 
 func (ml *MemberList) ContainsMemberID(memberID string) bool {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
 	return ml.members.ContainsID(memberID)
 }
 
@@ -8,14 +8,24 @@ type MemberStrategy interface {
 	GetActivator(senderAddress string) string
 }
 
+// IdentityActivatorStrategy is implemented by the member strategies choosing the member activating an identity. The
+// identity lookup asks the strategy of the kind on the member owning the partition of the identity, the owner keeps
+// track of the activation so that the identity is activated once even if the members choose differently.
+type IdentityActivatorStrategy interface {
+	MemberStrategy
+	GetIdentityActivator(identity string) string
+}
+
 type simpleMemberStrategy struct {
+	cluster *Cluster
+	kind    string
 	members Members
 	rr      *SimpleRoundRobin
 	rdv     *Rendezvous
 }
 
 func newDefaultMemberStrategy(cluster *Cluster, kind string) MemberStrategy {
-	ms := &simpleMemberStrategy{members: make(Members, 0)}
+	ms := &simpleMemberStrategy{cluster: cluster, kind: kind, members: make(Members, 0)}
 	ms.rr = NewSimpleRoundRobin(MemberStrategy(ms))
 	ms.rdv = NewRendezvous()
 	return ms
@@ -24,6 +34,7 @@ func newDefaultMemberStrategy(cluster *Cluster, kind string) MemberStrategy {
 func (m *simpleMemberStrategy) AddMember(member *Member) {
 	m.members = append(m.members, member)
 	m.rdv.UpdateMembers(m.members)
+	m.updatePlacement()
 }
 
 func (m *simpleMemberStrategy) UpdateMember(member *Member) {
@@ -40,17 +51,28 @@ func (m *simpleMemberStrategy) RemoveMember(member *Member) {
 		if mb.Address() == member.Address() {
 			m.members = append(m.members[:i], m.members[i+1:]...)
 			m.rdv.UpdateMembers(m.members)
+			m.updatePlacement()
 			return
 		}
 	}
 }
 
+// updatePlacement places the partitions with the placement of the kind, the anti-affinity only applies to the
+// kinds hosted by the members of the kind
+func (m *simpleMemberStrategy) updatePlacement() {
+	if m.cluster == nil {
+		return
+	}
+
+	m.rdv.SetPlacements(m.cluster.KindPlacements())
+}
+
 func (m *simpleMemberStrategy) GetAllMembers() Members {
 	return m.members
 }
 
 func (m *simpleMemberStrategy) GetPartition(key string) string {
-	return m.rdv.GetByIdentity(key)
+	return m.rdv.GetByClusterIdentity(&ClusterIdentity{Kind: m.kind, Identity: key})
 }
 
 func (m *simpleMemberStrategy) GetActivator(senderAddress string) string {
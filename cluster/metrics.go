@@ -0,0 +1,105 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/metrics"
+	"github.com/asynkron/protoactor-go/remote"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// The reasons cluster requests fail for, recorded by the failure count
+const (
+	RequestFailureTimeout          = "timeout"
+	RequestFailureDeadLetter       = "dead_letter"
+	RequestFailureDeadlineExceeded = "deadline_exceeded"
+	RequestFailureNoActivation     = "no_activation" // the identity lookup did not return a PID
+	RequestFailureError            = "error"
+)
+
+// requestFailureReason returns the reason a request attempt failed with err for
+func requestFailureReason(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return RequestFailureDeadlineExceeded
+	case errors.Is(err, actor.ErrTimeout), errors.Is(err, remote.ErrTimeout):
+		return RequestFailureTimeout
+	case errors.Is(err, actor.ErrDeadLetter), errors.Is(err, remote.ErrDeadLetter):
+		return RequestFailureDeadLetter
+	default:
+		return RequestFailureError
+	}
+}
+
+// clusterMetrics records the metrics of the cluster requests when the actor system has metrics enabled, its methods
+// do nothing on a nil value
+type clusterMetrics struct {
+	system      *actor.ActorSystem
+	instruments *metrics.ClusterMetrics
+}
+
+func newClusterMetrics(system *actor.ActorSystem) *clusterMetrics {
+	if system.Config.MetricsProvider == nil {
+		return nil
+	}
+
+	return &clusterMetrics{
+		system:      system,
+		instruments: metrics.NewClusterMetrics(system.Logger()),
+	}
+}
+
+func (m *clusterMetrics) labels(kind string, labels ...attribute.KeyValue) metric.MeasurementOption {
+	return metric.WithAttributes(append(labels,
+		attribute.String("address", m.system.Address()),
+		attribute.String("clusterkind", kind),
+	)...)
+}
+
+func (m *clusterMetrics) requestDuration(kind string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.instruments.ClusterRequestDurationHistogram.Record(context.Background(), duration.Seconds(), m.labels(kind))
+}
+
+func (m *clusterMetrics) requestRetry(kind string) {
+	if m == nil {
+		return
+	}
+
+	m.instruments.ClusterRequestRetryCount.Add(context.Background(), 1, m.labels(kind))
+}
+
+func (m *clusterMetrics) requestFailure(kind, reason string) {
+	if m == nil {
+		return
+	}
+
+	m.instruments.ClusterRequestFailureCount.Add(context.Background(), 1, m.labels(kind, attribute.String("reason", reason)))
+}
+
+func (m *clusterMetrics) pidCache(kind string, hit bool) {
+	if m == nil {
+		return
+	}
+
+	if hit {
+		m.instruments.ClusterPidCacheHitCount.Add(context.Background(), 1, m.labels(kind))
+	} else {
+		m.instruments.ClusterPidCacheMissCount.Add(context.Background(), 1, m.labels(kind))
+	}
+}
+
+func (m *clusterMetrics) identityLookup(kind string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.instruments.ClusterIdentityLookupDurationHistogram.Record(context.Background(), duration.Seconds(), m.labels(kind))
+}
@@ -0,0 +1,120 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestRequestFailureReason(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		reason string
+	}{
+		{actor.ErrTimeout, RequestFailureTimeout},
+		{remote.ErrTimeout, RequestFailureTimeout},
+		{actor.ErrDeadLetter, RequestFailureDeadLetter},
+		{remote.ErrDeadLetter, RequestFailureDeadLetter},
+		{fmt.Errorf("request failed: %w", context.DeadlineExceeded), RequestFailureDeadlineExceeded},
+		{fmt.Errorf("boom"), RequestFailureError},
+	} {
+		assert.Equal(t, tc.reason, requestFailureReason(tc.err), tc.err.Error())
+	}
+}
+
+// collectSum returns the sum of the int64 counter with the given name whose data points have the attribute, or
+// all of them if the attribute is empty
+func collectSum(t *testing.T, reader *sdkmetric.ManualReader, name string, attr attribute.KeyValue) int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	var sum int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				if value, ok := dp.Attributes.Value(attr.Key); attr.Key == "" || (ok && value == attr.Value) {
+					sum += dp.Value
+				}
+			}
+		}
+	}
+
+	return sum
+}
+
+func collectHistogramCount(t *testing.T, reader *sdkmetric.ManualReader, name string) uint64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	var count uint64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+
+			for _, dp := range m.Data.(metricdata.Histogram[float64]).DataPoints {
+				count += dp.Count
+			}
+		}
+	}
+
+	return count
+}
+
+func TestDefaultContext_RequestMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	otel.SetMeterProvider(provider)
+
+	system := actor.NewActorSystemWithConfig(actor.Configure(actor.WithMetricProviders(provider)))
+	lookup := &fakeIdentityLookup{}
+	c := New(system, Configure(t.Name(), nil, lookup, remote.Configure("127.0.0.1", 0)))
+	c.IdentityLookup = lookup
+
+	retryAction := WithRetryAction(func(i int) int { return i + 1 })
+
+	// the identity lookup returns no PID
+	_, err := c.Request("missing", "kind", "hello", WithRetryCount(2), retryAction)
+	assert.Error(t, err)
+
+	assert.Equal(t, int64(2), collectSum(t, reader, "protoactor_cluster_request_retry_count", attribute.KeyValue{}))
+	assert.Equal(t, int64(1), collectSum(t, reader, "protoactor_cluster_request_failure_count", attribute.String("reason", RequestFailureNoActivation)))
+	assert.Equal(t, int64(2), collectSum(t, reader, "protoactor_cluster_pid_cache_miss_count", attribute.String("clusterkind", "kind")))
+	assert.Equal(t, uint64(2), collectHistogramCount(t, reader, "protoactor_cluster_identity_lookup_duration_seconds"))
+
+	pid := system.Root.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			ctx.Respond(msg)
+		}
+	}))
+	lookup.m.Store("echo", pid)
+
+	for i := 0; i < 2; i++ {
+		res, err := c.Request("echo", "kind", "hello", retryAction)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", res)
+	}
+
+	assert.Equal(t, int64(1), collectSum(t, reader, "protoactor_cluster_pid_cache_hit_count", attribute.KeyValue{}))
+	assert.Equal(t, int64(3), collectSum(t, reader, "protoactor_cluster_pid_cache_miss_count", attribute.KeyValue{}))
+	assert.Equal(t, int64(1), collectSum(t, reader, "protoactor_cluster_request_failure_count", attribute.KeyValue{}))
+	assert.Equal(t, uint64(3), collectHistogramCount(t, reader, "protoactor_cluster_request_duration_seconds"))
+}
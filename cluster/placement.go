@@ -0,0 +1,214 @@
+package cluster
+
+import (
+	"log/slog"
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// PlacementKey is the gossip key under which every member publishes its labels, its kinds and their placements
+const PlacementKey string = "placement"
+
+// PlacementOption configures the placement of a kind
+type PlacementOption func(placement *KindPlacement)
+
+// RequireLabel places the grains of the kind only on members with the label, e.g. RequireLabel("role", "backend").
+// Grains can't be placed while no member has it.
+func RequireLabel(key, value string) PlacementOption {
+	return func(placement *KindPlacement) {
+		if placement.RequiredLabels == nil {
+			placement.RequiredLabels = make(map[string]string)
+		}
+
+		placement.RequiredLabels[key] = value
+	}
+}
+
+// PreferLabel places the grains of the kind on members with the label while there are any, e.g.
+// PreferLabel("hardware", "gpu"), and on the other members otherwise
+func PreferLabel(key, value string) PlacementOption {
+	return func(placement *KindPlacement) {
+		if placement.PreferredLabels == nil {
+			placement.PreferredLabels = make(map[string]string)
+		}
+
+		placement.PreferredLabels[key] = value
+	}
+}
+
+// AntiAffinity places a grain of the kind on a member whose value of the label, e.g. "zone", differs from the one of
+// the members the grains with the same identity of the other kinds are placed on. It is best effort, the grain is
+// placed next to them when no other member qualifies. Set it on one of the kinds only, the grains of the other kinds
+// are placed without their own anti-affinity.
+func AntiAffinity(label string, kinds ...string) PlacementOption {
+	return func(placement *KindPlacement) {
+		placement.AntiAffinityLabel = label
+		placement.AntiAffinityKinds = append(placement.AntiAffinityKinds, kinds...)
+	}
+}
+
+// HasLabels returns true if the member has all the labels
+func (m *Member) HasLabels(labels map[string]string) bool {
+	for key, value := range labels {
+		if v, ok := m.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// KindPlacements returns the placements of the kinds, the ones of the kinds not configured on the local member are
+// learned through gossip
+func (c *Cluster) KindPlacements() map[string]*KindPlacement {
+	placements := c.MemberList.gossipedPlacements()
+
+	for name, kind := range c.Config.Kinds {
+		if kind.Placement != nil {
+			placements[name] = kind.Placement
+		}
+	}
+
+	for name, kind := range c.activatedKinds() {
+		if kind.Placement != nil {
+			placements[name] = kind.Placement
+		}
+	}
+
+	return placements
+}
+
+// KindPlacement returns the placement of the kind, nil if its grains may be placed on any member
+func (c *Cluster) KindPlacement(kind string) *KindPlacement {
+	return c.KindPlacements()[kind]
+}
+
+// NewRendezvous returns a Rendezvous placing the grains with the placements of the kinds
+func (c *Cluster) NewRendezvous(members Members) *Rendezvous {
+	rdv := NewRendezvous()
+	rdv.UpdateMembers(members)
+	rdv.SetPlacements(c.KindPlacements())
+
+	return rdv
+}
+
+// gossipPlacement publishes the labels and the kinds of the local member and the placements of its kinds
+func (c *Cluster) gossipPlacement() {
+	state := &MemberPlacement{
+		Labels:      c.Config.Labels,
+		Kinds:       make(map[string]*KindPlacement),
+		MemberKinds: c.GetClusterKinds(),
+	}
+
+	for name, kind := range c.activatedKinds() {
+		if kind.Placement != nil {
+			state.Kinds[name] = kind.Placement
+		}
+	}
+
+	c.MemberList.updatePlacement(c.ActorSystem.ID, state)
+	c.Gossip.SetState(PlacementKey, state)
+}
+
+// updatePlacement applies the labels and placements gossiped by a member, the topology is published again when they
+// changed so that the grains are placed accordingly
+func (ml *MemberList) updatePlacement(memberID string, state *MemberPlacement) {
+	ml.placementMutex.Lock()
+	changed := !maps.Equal(ml.labels[memberID], state.Labels) || !sameKinds(ml.kinds[memberID], state.MemberKinds)
+	if len(state.Labels) > 0 {
+		ml.labels[memberID] = state.Labels
+	} else {
+		delete(ml.labels, memberID)
+	}
+
+	if len(state.MemberKinds) > 0 {
+		ml.kinds[memberID] = state.MemberKinds
+	} else {
+		delete(ml.kinds, memberID)
+	}
+
+	for kind, placement := range state.Kinds {
+		if current, ok := ml.placements[kind]; !ok || !proto.Equal(current, placement) {
+			ml.placements[kind] = placement
+			changed = true
+		}
+	}
+
+	ml.placementMutex.Unlock()
+
+	if !changed {
+		return
+	}
+
+	ml.cluster.Logger().Debug("Member placement changed", slog.String("member", memberID), slog.Any("labels", state.Labels), slog.Any("kinds", state.MemberKinds))
+
+	ml.mutex.Lock()
+	defer ml.mutex.Unlock()
+
+	ml.placementChanged = true
+
+	if ml.providerMembers != nil {
+		ml.updateClusterTopology(ml.providerMembers)
+	}
+}
+
+// gossipedPlacements does not take the lock of the topology, so that the subscribers of the topology can call it
+func (ml *MemberList) gossipedPlacements() map[string]*KindPlacement {
+	ml.placementMutex.RLock()
+	defer ml.placementMutex.RUnlock()
+
+	return maps.Clone(ml.placements)
+}
+
+// withPlacement returns the members with the gossiped labels of those the ClusterProvider reported without labels and
+// with the gossiped kinds, the kinds the ClusterProvider reports may predate the ones registered at runtime
+func (ml *MemberList) withPlacement(members Members) Members {
+	ml.placementMutex.RLock()
+	defer ml.placementMutex.RUnlock()
+
+	placed := make(Members, 0, len(members))
+
+	for _, m := range members {
+		labels, relabel := ml.labels[m.Id]
+		relabel = relabel && len(m.Labels) == 0
+
+		kinds, rekind := ml.kinds[m.Id]
+		rekind = rekind && !sameKinds(m.Kinds, kinds)
+
+		if relabel || rekind {
+			placedMember := &Member{
+				Host:   m.Host,
+				Port:   m.Port,
+				Id:     m.Id,
+				Kinds:  m.Kinds,
+				Labels: m.Labels,
+			}
+			if relabel {
+				placedMember.Labels = labels
+			}
+			if rekind {
+				placedMember.Kinds = kinds
+			}
+			m = placedMember
+		}
+
+		placed = append(placed, m)
+	}
+
+	return placed
+}
+
+// sameKinds returns true if both contain the same kinds, in any order
+func sameKinds(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
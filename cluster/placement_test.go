@@ -0,0 +1,166 @@
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newZonedMembersForTest returns members alternating between the zones "a" and "b"
+func newZonedMembersForTest(count int, kinds ...string) Members {
+	members := newMembersForTest(count, kinds...)
+	for i, m := range members {
+		m.Labels = map[string]string{"zone": string(rune('a' + i%2))}
+	}
+
+	return members
+}
+
+func newPlacementForTest(opts ...PlacementOption) *KindPlacement {
+	kind := &Kind{Kind: "kind"}
+	kind.WithPlacement(opts...)
+
+	return kind.Placement
+}
+
+func TestRendezvous_RequiredLabels(t *testing.T) {
+	members := newZonedMembersForTest(6)
+	rdv := NewRendezvous()
+	rdv.UpdateMembers(members)
+	rdv.SetPlacements(map[string]*KindPlacement{"kind": newPlacementForTest(RequireLabel("zone", "b"))})
+
+	byAddress := make(map[string]*Member)
+	for _, m := range members {
+		byAddress[m.Address()] = m
+	}
+
+	for i := 0; i < 100; i++ {
+		address := rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "kind", Identity: fmt.Sprintf("identity-%d", i)})
+		assert.Equal(t, "b", byAddress[address].Labels["zone"])
+	}
+
+	rdv.SetPlacements(map[string]*KindPlacement{"kind": newPlacementForTest(RequireLabel("zone", "c"))})
+	assert.Empty(t, rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "kind", Identity: "identity"}))
+}
+
+func TestRendezvous_PreferredLabels(t *testing.T) {
+	members := newZonedMembersForTest(6)
+	members[3].Labels["hardware"] = "gpu"
+
+	rdv := NewRendezvous()
+	rdv.UpdateMembers(members)
+	rdv.SetPlacements(map[string]*KindPlacement{"kind": newPlacementForTest(PreferLabel("hardware", "gpu"))})
+
+	for i := 0; i < 100; i++ {
+		address := rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "kind", Identity: fmt.Sprintf("identity-%d", i)})
+		assert.Equal(t, members[3].Address(), address)
+	}
+
+	// falls back to the other members when none has the preferred labels
+	delete(members[3].Labels, "hardware")
+	rdv.UpdateMembers(members)
+
+	owners := make(map[string]struct{})
+	for i := 0; i < 100; i++ {
+		owners[rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "kind", Identity: fmt.Sprintf("identity-%d", i)})] = struct{}{}
+	}
+
+	assert.Greater(t, len(owners), 1)
+}
+
+func TestRendezvous_AntiAffinity(t *testing.T) {
+	members := newZonedMembersForTest(6, "primary", "backup")
+	rdv := NewRendezvous()
+	rdv.UpdateMembers(members)
+	rdv.SetPlacements(map[string]*KindPlacement{"backup": {AntiAffinityLabel: "zone", AntiAffinityKinds: []string{"primary"}}})
+
+	zones := make(map[string]string)
+	for _, m := range members {
+		zones[m.Address()] = m.Labels["zone"]
+	}
+
+	for i := 0; i < 100; i++ {
+		identity := fmt.Sprintf("identity-%d", i)
+		primary := rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "primary", Identity: identity})
+		backup := rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "backup", Identity: identity})
+
+		assert.NotEqual(t, zones[primary], zones[backup], identity)
+	}
+
+	// places the grains next to each other when there is a single zone
+	single := newZonedMembersForTest(1, "primary", "backup")
+	rdv.UpdateMembers(single)
+	assert.Equal(t, single[0].Address(), rdv.GetByClusterIdentity(&ClusterIdentity{Kind: "backup", Identity: "identity"}))
+}
+
+func TestMemberList_UpdatePlacement(t *testing.T) {
+	c := newClusterForTest("test-UpdatePlacement", nil)
+
+	var topologies []*ClusterTopology
+	c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		if topology, ok := evt.(*ClusterTopology); ok {
+			topologies = append(topologies, topology)
+		}
+	})
+
+	members := newMembersForTest(2)
+	c.MemberList.UpdateClusterTopology(members)
+	assert.Len(t, topologies, 1)
+
+	placement := newPlacementForTest(RequireLabel("zone", "a"))
+	c.MemberList.updatePlacement("memberId-1", &MemberPlacement{
+		Labels: map[string]string{"zone": "a"},
+		Kinds:  map[string]*KindPlacement{"kind": placement},
+	})
+
+	assert.Len(t, topologies, 2)
+	assert.Equal(t, map[string]string{"zone": "a"}, c.MemberList.Members().GetMemberById("memberId-1").Labels)
+	assert.Empty(t, members[1].Labels, "the members of the provider are not modified")
+	assert.Equal(t, placement, c.KindPlacement("kind"))
+
+	// the partitions of the kind are placed on the labeled member
+	assert.Equal(t, members[1].Address(), c.MemberList.getPartitionMemberV2(&ClusterIdentity{Kind: "kind", Identity: "identity"}))
+
+	// gossiping the same placement again does not change the topology
+	c.MemberList.updatePlacement("memberId-1", &MemberPlacement{
+		Labels: map[string]string{"zone": "a"},
+		Kinds:  map[string]*KindPlacement{"kind": placement},
+	})
+	assert.Len(t, topologies, 2)
+}
+
+func TestMemberList_UpdatePlacement_Kinds(t *testing.T) {
+	c := newClusterForTest("test-UpdatePlacement-Kinds", nil)
+
+	var topologies []*ClusterTopology
+	c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		if topology, ok := evt.(*ClusterTopology); ok {
+			topologies = append(topologies, topology)
+		}
+	})
+
+	members := newMembersForTest(2)
+	c.MemberList.UpdateClusterTopology(members)
+	assert.Len(t, topologies, 1)
+	assert.Empty(t, c.MemberList.getPartitionMemberV2(&ClusterIdentity{Kind: "other", Identity: "identity"}))
+
+	// the member registered a kind at runtime
+	c.MemberList.updatePlacement("memberId-1", &MemberPlacement{MemberKinds: []string{"other", "kind"}})
+
+	assert.Len(t, topologies, 2)
+	assert.ElementsMatch(t, []string{"kind", "other"}, c.MemberList.Members().GetMemberById("memberId-1").Kinds)
+	assert.Equal(t, []string{"kind"}, members[1].Kinds, "the members of the provider are not modified")
+	assert.Equal(t, members[1].Address(), c.MemberList.getPartitionMemberV2(&ClusterIdentity{Kind: "other", Identity: "identity"}))
+
+	// gossiping the same kinds in another order does not change the topology
+	c.MemberList.updatePlacement("memberId-1", &MemberPlacement{MemberKinds: []string{"kind", "other"}})
+	assert.Len(t, topologies, 2)
+
+	// the member unregistered the kind
+	c.MemberList.updatePlacement("memberId-1", &MemberPlacement{MemberKinds: []string{"kind"}})
+
+	assert.Len(t, topologies, 3)
+	assert.Equal(t, []string{"kind"}, c.MemberList.Members().GetMemberById("memberId-1").Kinds)
+	assert.Empty(t, c.MemberList.getPartitionMemberV2(&ClusterIdentity{Kind: "other", Identity: "identity"}))
+}
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"context"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
@@ -12,7 +13,8 @@ const PubSubDeliveryName = "$pubsub-delivery"
 var pubsubExtensionID = extensions.NextExtensionID()
 
 type PubSub struct {
-	cluster *Cluster
+	cluster     *Cluster
+	deliveryPid *actor.PID
 }
 
 func NewPubSub(cluster *Cluster) *PubSub {
@@ -24,15 +26,37 @@ func NewPubSub(cluster *Cluster) *PubSub {
 }
 
 // Start the PubSubMemberDeliveryActor
-func (p *PubSub) Start() {
+func (p *PubSub) Start() error {
 	props := actor.PropsFromProducer(func() actor.Actor {
 		return NewPubSubMemberDeliveryActor(p.cluster.Config.PubSubConfig.SubscriberTimeout, p.cluster.Logger())
-	})
-	_, err := p.cluster.ActorSystem.Root.SpawnNamed(props, PubSubDeliveryName)
+	}, actor.WithSystemActor())
+	pid, err := p.cluster.ActorSystem.Root.SpawnNamed(props, PubSubDeliveryName)
 	if err != nil {
-		panic(err) // let it crash
+		return err
 	}
+	p.deliveryPid = pid
 	p.cluster.Logger().Info("Started Cluster PubSub")
+
+	return nil
+}
+
+// drain lets the delivery actor process the pending deliveries before it stops
+func (p *PubSub) drain(ctx context.Context) error {
+	if p.deliveryPid == nil {
+		return nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- p.cluster.ActorSystem.Root.PoisonFuture(p.deliveryPid).Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (p *PubSub) ExtensionID() extensions.ExtensionID {
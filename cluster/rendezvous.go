@@ -7,6 +7,7 @@ package cluster
 import (
 	"hash"
 	"hash/fnv"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -20,6 +21,7 @@ type Rendezvous struct {
 	hasher     hash.Hash32
 	hasherLock sync.Mutex
 	members    []*memberData
+	placements map[string]*KindPlacement
 }
 
 func NewRendezvous() *Rendezvous {
@@ -33,20 +35,30 @@ func (r *Rendezvous) GetByClusterIdentity(ci *ClusterIdentity) string {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	identity := ci.Identity
-	m := r.memberDataByKind(ci.Kind)
+	owner := r.owner(ci, true)
+	if owner == nil {
+		return ""
+	}
+
+	return owner.member.Address()
+}
+
+// owner returns the member of the kind satisfying its placement with the highest score for the identity. The
+// anti-affinity is not applied to the owners of the anti-affinity kinds, so that kinds avoiding each other are placed.
+func (r *Rendezvous) owner(ci *ClusterIdentity, antiAffinity bool) *memberData {
+	m := r.candidates(ci, antiAffinity)
 
 	l := len(m)
 
 	if l == 0 {
-		return ""
+		return nil
 	}
 
 	if l == 1 {
-		return m[0].member.Address()
+		return m[0]
 	}
 
-	keyBytes := []byte(identity)
+	keyBytes := []byte(ci.Identity)
 
 	var maxScore uint32
 	var maxMember *memberData
@@ -60,10 +72,91 @@ func (r *Rendezvous) GetByClusterIdentity(ci *ClusterIdentity) string {
 		}
 	}
 
-	if maxMember == nil {
-		return ""
+	return maxMember
+}
+
+// Rank returns the members of the kind satisfying its placement, ordered from the highest to the lowest score for
+// the identity. The first one is the member GetByClusterIdentity returns.
+func (r *Rendezvous) Rank(ci *ClusterIdentity) Members {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	m := r.candidates(ci, true)
+	keyBytes := []byte(ci.Identity)
+	scores := make(map[*memberData]uint32, len(m))
+
+	for _, node := range m {
+		scores[node] = r.hash(node.hashBytes, keyBytes)
+	}
+
+	sort.SliceStable(m, func(i, j int) bool {
+		return scores[m[i]] > scores[m[j]]
+	})
+
+	ranked := make(Members, 0, len(m))
+	for _, node := range m {
+		ranked = append(ranked, node.member)
+	}
+
+	return ranked
+}
+
+func (r *Rendezvous) candidates(ci *ClusterIdentity, antiAffinity bool) []*memberData {
+	m := r.memberDataByKind(ci.Kind)
+
+	if placement := r.placements[ci.Kind]; placement != nil {
+		m = r.place(ci, placement, m, antiAffinity)
+	}
+
+	return m
+}
+
+// place filters the members by the required labels, the anti-affinity and the preferred labels of the placement
+func (r *Rendezvous) place(ci *ClusterIdentity, placement *KindPlacement, members []*memberData, antiAffinity bool) []*memberData {
+	members = filterMemberData(members, func(md *memberData) bool {
+		return md.member.HasLabels(placement.RequiredLabels)
+	})
+
+	if antiAffinity && placement.AntiAffinityLabel != "" {
+		avoid := make(map[string]struct{})
+
+		for _, kind := range placement.AntiAffinityKinds {
+			if kind == ci.Kind {
+				continue
+			}
+
+			owner := r.owner(&ClusterIdentity{Identity: ci.Identity, Kind: kind}, false)
+			if owner == nil {
+				continue
+			}
+
+			if value, ok := owner.member.Labels[placement.AntiAffinityLabel]; ok {
+				avoid[value] = struct{}{}
+			}
+		}
+
+		spread := filterMemberData(members, func(md *memberData) bool {
+			_, ok := avoid[md.member.Labels[placement.AntiAffinityLabel]]
+
+			return !ok
+		})
+
+		if len(spread) > 0 {
+			members = spread
+		}
 	}
-	return maxMember.member.Address()
+
+	if len(placement.PreferredLabels) > 0 {
+		preferred := filterMemberData(members, func(md *memberData) bool {
+			return md.member.HasLabels(placement.PreferredLabels)
+		})
+
+		if len(preferred) > 0 {
+			members = preferred
+		}
+	}
+
+	return members
 }
 
 func (r *Rendezvous) GetByIdentity(identity string) string {
@@ -85,6 +178,25 @@ func (r *Rendezvous) memberDataByKind(kind string) []*memberData {
 	return m
 }
 
+func filterMemberData(members []*memberData, keep func(md *memberData) bool) []*memberData {
+	kept := make([]*memberData, 0, len(members))
+	for _, md := range members {
+		if keep(md) {
+			kept = append(kept, md)
+		}
+	}
+
+	return kept
+}
+
+// SetPlacements sets the placements of the kinds, keyed by kind
+func (r *Rendezvous) SetPlacements(placements map[string]*KindPlacement) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.placements = placements
+}
+
 func (r *Rendezvous) UpdateMembers(members Members) {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
@@ -0,0 +1,353 @@
+package cluster
+
+import (
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// SingletonsKey is the gossip key under which every member publishes the cluster singletons running on it
+const SingletonsKey string = "singletons"
+
+var (
+	ErrSingletonAlreadyRegistered = errors.New("cluster: singleton already registered")
+	ErrMemberNotStarted           = errors.New("cluster: member not started")
+)
+
+// SingletonOption configures a ClusterSingleton
+type SingletonOption func(singleton *ClusterSingleton)
+
+// WithSingletonHandoverTimeout sets how long a new leader waits for the singleton to stop on the previous leader
+// before it starts it anyway, e.g. because the previous leader crashed. Default is 10 seconds.
+func WithSingletonHandoverTimeout(timeout time.Duration) SingletonOption {
+	return func(singleton *ClusterSingleton) {
+		singleton.handoverTimeout = timeout
+	}
+}
+
+// WithSingletonBufferSize sets how many messages the proxy buffers while the singleton is not running, the
+// oldest ones are sent to dead letters once it is full. Default is 1000.
+func WithSingletonBufferSize(size int) SingletonOption {
+	return func(singleton *ClusterSingleton) {
+		singleton.bufferSize = size
+	}
+}
+
+// ClusterSingleton is an actor of which exactly one instance runs in the cluster, on the elected leader. When the
+// leader changes, the instance is stopped on the previous leader before it is started on the new one.
+//
+// Every member registers the singleton with Cluster.RegisterSingleton, the messages sent to PID are forwarded to
+// the instance wherever it runs and buffered while it is handed over.
+type ClusterSingleton struct {
+	name            string
+	props           *actor.Props
+	handoverTimeout time.Duration
+	bufferSize      int
+	pid             *actor.PID
+}
+
+// Name returns the name the singleton was registered with
+func (s *ClusterSingleton) Name() string {
+	return s.name
+}
+
+// PID returns the proxy of the singleton on the local member
+func (s *ClusterSingleton) PID() *actor.PID {
+	return s.pid
+}
+
+func singletonInstanceID(name string) string {
+	return "singleton/" + name + "/instance"
+}
+
+// RegisterSingleton registers the singleton on the local member, it must be called on every member after
+// StartMember
+func (c *Cluster) RegisterSingleton(name string, props *actor.Props, opts ...SingletonOption) (*ClusterSingleton, error) {
+	if c.leaderElection == nil {
+		return nil, ErrMemberNotStarted
+	}
+
+	singleton := &ClusterSingleton{
+		name:            name,
+		props:           props,
+		handoverTimeout: 10 * time.Second,
+		bufferSize:      1000,
+	}
+
+	for _, opt := range opts {
+		opt(singleton)
+	}
+
+	return singleton, c.singletons.register(singleton)
+}
+
+type singletonTick struct {
+	leader *Member
+	hosts  []*Member // the members the singleton runs on according to gossip
+}
+
+// singletons keeps the singletons registered on the local member and gossips the ones running on it
+type singletons struct {
+	cluster *Cluster
+
+	mu      sync.Mutex
+	byName  map[string]*ClusterSingleton
+	running map[string]struct{}
+}
+
+func newSingletons(cluster *Cluster) *singletons {
+	return &singletons{
+		cluster: cluster,
+		byName:  make(map[string]*ClusterSingleton),
+		running: make(map[string]struct{}),
+	}
+}
+
+func (s *singletons) register(singleton *ClusterSingleton) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.byName[singleton.name]; ok {
+		return ErrSingletonAlreadyRegistered
+	}
+
+	pid, err := s.cluster.ActorSystem.Root.SpawnNamed(actor.PropsFromProducer(func() actor.Actor {
+		return &singletonActor{singletons: s, singleton: singleton}
+	}, actor.WithSystemActor()), "singleton/"+singleton.name)
+	if err != nil {
+		return err
+	}
+
+	singleton.pid = pid
+	s.byName[singleton.name] = singleton
+
+	return nil
+}
+
+// stop stops the proxies of the singletons along with the instances running on the local member, the singletons are
+// gossiped as stopped so that the leader starts them
+func (s *singletons) stop() {
+	s.mu.Lock()
+	registered := make([]*ClusterSingleton, 0, len(s.byName))
+	for _, singleton := range s.byName {
+		registered = append(registered, singleton)
+	}
+
+	running := len(s.running) > 0
+	s.byName = make(map[string]*ClusterSingleton)
+	s.running = make(map[string]struct{})
+	s.mu.Unlock()
+
+	for _, singleton := range registered {
+		// the proxy may already be stopped by the shutdown of the actor system
+		_ = s.cluster.ActorSystem.Root.PoisonFuture(singleton.pid).Wait()
+	}
+
+	if running {
+		s.cluster.Gossip.SetState(SingletonsKey, &RunningSingletons{})
+	}
+}
+
+func (s *singletons) setRunning(name string, running bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if running {
+		s.running[name] = struct{}{}
+	} else {
+		delete(s.running, name)
+	}
+
+	names := make([]string, 0, len(s.running))
+	for n := range s.running {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+	s.cluster.Gossip.SetState(SingletonsKey, &RunningSingletons{Names: names})
+}
+
+// update tells the proxies of the singletons who the leader is and where the singletons run
+func (s *singletons) update(leader *Member) {
+	s.mu.Lock()
+	registered := make([]*ClusterSingleton, 0, len(s.byName))
+	for _, singleton := range s.byName {
+		registered = append(registered, singleton)
+	}
+	s.mu.Unlock()
+
+	if len(registered) == 0 {
+		return
+	}
+
+	state, err := s.cluster.Gossip.GetState(SingletonsKey)
+	if err != nil {
+		return
+	}
+
+	members := s.cluster.MemberList.Members()
+	hosts := make(map[string][]*Member)
+
+	for id, kv := range state {
+		member := members.GetMemberById(id)
+		if member == nil {
+			continue
+		}
+
+		var running RunningSingletons
+		if err := kv.Value.UnmarshalTo(&running); err != nil {
+			continue
+		}
+
+		for _, name := range running.Names {
+			hosts[name] = append(hosts[name], member)
+		}
+	}
+
+	for _, singleton := range registered {
+		s.cluster.ActorSystem.Root.Send(singleton.pid, &singletonTick{leader: leader, hosts: hosts[singleton.name]})
+	}
+}
+
+type bufferedMessage struct {
+	message interface{}
+	sender  *actor.PID
+}
+
+// singletonActor is the proxy of a singleton, on the leader it is also the parent of the instance
+type singletonActor struct {
+	singletons *singletons
+	singleton  *ClusterSingleton
+
+	instance         *actor.PID // the instance on the local member
+	stopping         bool
+	target           *actor.PID // the instance the messages are forwarded to
+	handoverDeadline time.Time
+	buffer           []bufferedMessage
+}
+
+func (a *singletonActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started, *actor.Stopping, *actor.Stopped, *actor.Restarting:
+	case *singletonTick:
+		a.onTick(ctx, msg)
+	case *actor.Terminated:
+		a.onTerminated(ctx, msg.Who)
+	default:
+		if a.target != nil {
+			ctx.Forward(a.target)
+			return
+		}
+
+		a.bufferMessage(ctx, msg)
+	}
+}
+
+func (a *singletonActor) onTick(ctx actor.Context, tick *singletonTick) {
+	self := a.singletons.cluster.ActorSystem.ID
+
+	if tick.leader == nil || tick.leader.Id != self {
+		a.handoverDeadline = time.Time{}
+
+		if a.instance != nil && !a.stopping {
+			// hands the singleton over, the new leader starts it once it is gossiped as stopped here
+			ctx.Logger().Info("Stopping cluster singleton, no longer the leader", slog.String("singleton", a.singleton.name))
+			a.stopping = true
+			ctx.Poison(a.instance)
+		}
+
+		var target *actor.PID
+		if tick.leader != nil {
+			for _, host := range tick.hosts {
+				if host.Id == tick.leader.Id {
+					target = actor.NewPID(host.Address(), singletonInstanceID(a.singleton.name))
+				}
+			}
+		}
+
+		a.setTarget(ctx, target)
+
+		return
+	}
+
+	if a.instance != nil {
+		return
+	}
+
+	if a.handoverDeadline.IsZero() {
+		a.handoverDeadline = time.Now().Add(a.singleton.handoverTimeout)
+	}
+
+	for _, host := range tick.hosts {
+		if host.Id != self && time.Now().Before(a.handoverDeadline) {
+			// waits for the previous leader to stop its instance
+			return
+		}
+	}
+
+	instance, err := ctx.SpawnNamed(a.singleton.props, "instance")
+	if err != nil {
+		ctx.Logger().Error("Failed to start cluster singleton", slog.String("singleton", a.singleton.name), slog.Any("error", err))
+		return
+	}
+
+	ctx.Logger().Info("Started cluster singleton", slog.String("singleton", a.singleton.name))
+	a.instance = instance
+	a.singletons.setRunning(a.singleton.name, true)
+	a.setTarget(ctx, instance)
+}
+
+func (a *singletonActor) onTerminated(ctx actor.Context, who *actor.PID) {
+	if a.instance != nil && a.instance.Equal(who) {
+		a.instance = nil
+		a.stopping = false
+		a.singletons.setRunning(a.singleton.name, false)
+	}
+
+	if a.target != nil && a.target.Equal(who) {
+		a.target = nil
+	}
+}
+
+func (a *singletonActor) setTarget(ctx actor.Context, target *actor.PID) {
+	if a.target == target || (a.target != nil && a.target.Equal(target)) {
+		return
+	}
+
+	if a.target != nil && !a.target.Equal(a.instance) {
+		ctx.Unwatch(a.target)
+	}
+
+	a.target = target
+	if target == nil {
+		return
+	}
+
+	if !target.Equal(a.instance) {
+		ctx.Watch(target)
+	}
+
+	for _, m := range a.buffer {
+		if m.sender != nil {
+			ctx.RequestWithCustomSender(target, m.message, m.sender)
+		} else {
+			ctx.Send(target, m.message)
+		}
+	}
+
+	a.buffer = nil
+}
+
+func (a *singletonActor) bufferMessage(ctx actor.Context, message interface{}) {
+	a.buffer = append(a.buffer, bufferedMessage{message: message, sender: ctx.Sender()})
+
+	for len(a.buffer) > a.singleton.bufferSize {
+		dropped := a.buffer[0]
+		a.buffer = a.buffer[1:]
+		ctx.ActorSystem().DeadLetter.SendUserMessage(ctx.Self(), &actor.MessageEnvelope{Message: dropped.message, Sender: dropped.sender})
+	}
+}
@@ -0,0 +1,372 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/eventstream"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartedAtKey is the gossip key under which every member publishes when it started, the oldest member is elected
+// leader and survives with KeepOldest
+const StartedAtKey string = "started-at"
+
+// Partition is the view a member has of the cluster when some members became unreachable
+type Partition struct {
+	Self        *Member              // the local member, it is part of Reachable
+	Reachable   Members              // the members on the side of the local member
+	Unreachable Members              // the members on the other side
+	StartedAt   map[string]time.Time // when the members started, keyed by member ID, missing for unknown ones
+}
+
+// SplitBrainStrategy decides which side of a partition survives. Every member decides on its own, from its own
+// view, the strategy must make the sides reach opposite decisions.
+type SplitBrainStrategy interface {
+	// Name returns the name of the strategy, used in logs and events
+	Name() string
+	// Survives returns true if the reachable side of the partition survives, the members on the other side are
+	// downed then, otherwise the local member downs itself
+	Survives(partition *Partition) bool
+}
+
+// SplitBrainDecision is published on the EventStream when the split-brain resolver downed a side of a partition
+type SplitBrainDecision struct {
+	Strategy    string
+	Survived    bool // true if the local side survived and the unreachable members were downed
+	Reachable   Members
+	Unreachable Members
+}
+
+type keepMajority struct{}
+
+// KeepMajority keeps the side with more members. On a tie the side with the member with the lowest address
+// survives.
+func KeepMajority() SplitBrainStrategy {
+	return keepMajority{}
+}
+
+func (keepMajority) Name() string { return "keep-majority" }
+
+func (keepMajority) Survives(partition *Partition) bool {
+	switch {
+	case len(partition.Reachable) > len(partition.Unreachable):
+		return true
+	case len(partition.Reachable) < len(partition.Unreachable):
+		return false
+	default:
+		return lowestAddress(partition.Reachable) < lowestAddress(partition.Unreachable)
+	}
+}
+
+type staticQuorum struct {
+	size int
+}
+
+// StaticQuorum keeps the side with at least size members. The size should be more than half of the number of
+// members the cluster is meant to have, so that at most one side survives.
+func StaticQuorum(size int) SplitBrainStrategy {
+	return staticQuorum{size: size}
+}
+
+func (s staticQuorum) Name() string { return fmt.Sprintf("static-quorum(%d)", s.size) }
+
+func (s staticQuorum) Survives(partition *Partition) bool {
+	return len(partition.Reachable) >= s.size
+}
+
+type keepOldest struct {
+	downIfAlone bool
+}
+
+// KeepOldest keeps the side with the member that started first. With downIfAlone, the oldest member downs itself
+// instead when the other side has all the other members, so that a single failing member cannot take down the
+// cluster.
+func KeepOldest(downIfAlone bool) SplitBrainStrategy {
+	return keepOldest{downIfAlone: downIfAlone}
+}
+
+func (s keepOldest) Name() string { return "keep-oldest" }
+
+func (s keepOldest) Survives(partition *Partition) bool {
+	oldestReachable := oldest(partition.Reachable, partition.StartedAt)
+	oldestUnreachable := oldest(partition.Unreachable, partition.StartedAt)
+	hasOldest := olderThan(oldestReachable, oldestUnreachable, partition.StartedAt)
+
+	if s.downIfAlone {
+		if hasOldest && len(partition.Reachable) == 1 && len(partition.Unreachable) > 0 {
+			return false
+		}
+
+		if !hasOldest && len(partition.Unreachable) == 1 && len(partition.Reachable) > 0 {
+			return true
+		}
+	}
+
+	return hasOldest
+}
+
+type keepReferee struct {
+	address    string
+	minMembers int
+}
+
+// KeepReferee keeps the side with the member at the given address, as long as that side has at least minMembers
+// members. All the members are downed when the referee is on neither side.
+func KeepReferee(address string, minMembers int) SplitBrainStrategy {
+	return keepReferee{address: address, minMembers: minMembers}
+}
+
+func (s keepReferee) Name() string { return fmt.Sprintf("keep-referee(%s)", s.address) }
+
+func (s keepReferee) Survives(partition *Partition) bool {
+	if len(partition.Reachable) < s.minMembers {
+		return false
+	}
+
+	for _, m := range partition.Reachable {
+		if m.Address() == s.address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lowestAddress(members Members) string {
+	lowest := ""
+	for _, m := range members {
+		if lowest == "" || m.Address() < lowest {
+			lowest = m.Address()
+		}
+	}
+
+	return lowest
+}
+
+// oldest returns the member that started first, the members whose start is unknown are the youngest
+func oldest(members Members, startedAt map[string]time.Time) *Member {
+	var res *Member
+	for _, m := range members {
+		if res == nil || olderThan(m, res, startedAt) {
+			res = m
+		}
+	}
+
+	return res
+}
+
+func olderThan(m, other *Member, startedAt map[string]time.Time) bool {
+	if m == nil {
+		return false
+	}
+
+	if other == nil {
+		return true
+	}
+
+	mStarted, mKnown := startedAt[m.Id]
+	otherStarted, otherKnown := startedAt[other.Id]
+
+	switch {
+	case mKnown && !otherKnown:
+		return true
+	case !mKnown && otherKnown:
+		return false
+	case mKnown && !mStarted.Equal(otherStarted):
+		return mStarted.Before(otherStarted)
+	default:
+		return m.Address() < other.Address()
+	}
+}
+
+// splitBrainResolver collects the members that became unreachable, either suspected by the failure detector or
+// gone from the topology without leaving gracefully. Once the unreachable members did not change for the stable
+// after period, the strategy decides which side survives, the other one is downed.
+type splitBrainResolver struct {
+	cluster     *Cluster
+	strategy    SplitBrainStrategy
+	stableAfter time.Duration
+	sub         *eventstream.Subscription
+
+	mu          sync.Mutex
+	unreachable map[string]*Member
+	downed      map[string]struct{}
+	timer       *time.Timer
+	ages        map[string]time.Time
+}
+
+func newSplitBrainResolver(cluster *Cluster) *splitBrainResolver {
+	return &splitBrainResolver{
+		cluster:     cluster,
+		strategy:    cluster.Config.SplitBrainStrategy,
+		stableAfter: cluster.Config.SplitBrainStableAfter,
+		unreachable: make(map[string]*Member),
+		downed:      make(map[string]struct{}),
+		ages:        make(map[string]time.Time),
+	}
+}
+
+func (r *splitBrainResolver) start() {
+	r.sub = r.cluster.ActorSystem.EventStream.Subscribe(r.handle)
+}
+
+func (r *splitBrainResolver) stop() {
+	r.cluster.ActorSystem.EventStream.Unsubscribe(r.sub)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+}
+
+func (r *splitBrainResolver) handle(evt interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch e := evt.(type) {
+	case *MemberSuspected:
+		if _, ok := r.downed[e.MemberID]; ok || e.Member == nil {
+			return
+		}
+		r.unreachable[e.MemberID] = e.Member
+	case *MemberReachable:
+		if _, ok := r.unreachable[e.MemberID]; !ok {
+			return
+		}
+		delete(r.unreachable, e.MemberID)
+	case *ClusterTopology:
+		if len(e.Left) == 0 && len(e.Joined) == 0 {
+			return
+		}
+		for _, m := range e.Left {
+			if _, ok := r.downed[m.Id]; !ok {
+				r.unreachable[m.Id] = m
+			}
+		}
+	default:
+		return
+	}
+
+	r.reschedule()
+}
+
+// reschedule restarts the stable after period. The caller holds the lock.
+func (r *splitBrainResolver) reschedule() {
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+
+	if len(r.unreachable) > 0 {
+		r.timer = time.AfterFunc(r.stableAfter, r.decide)
+	}
+}
+
+func (r *splitBrainResolver) decide() {
+	gracefullyLeft, _ := r.cluster.Gossip.GetState(GracefullyLeftKey)
+	startedAt, _ := r.cluster.Gossip.GetState(StartedAtKey)
+
+	r.mu.Lock()
+
+	r.timer = nil
+
+	for id := range gracefullyLeft {
+		delete(r.unreachable, id)
+	}
+
+	if len(r.unreachable) == 0 {
+		r.mu.Unlock()
+		return
+	}
+
+	for id, kv := range startedAt {
+		var ts timestamppb.Timestamp
+		if err := kv.Value.UnmarshalTo(&ts); err == nil {
+			r.ages[id] = ts.AsTime()
+		}
+	}
+
+	self := r.cluster.ActorSystem.ID
+	partition := &Partition{StartedAt: make(map[string]time.Time, len(r.ages))}
+	for id, t := range r.ages {
+		partition.StartedAt[id] = t
+	}
+
+	for _, m := range r.cluster.MemberList.Members().Members() {
+		if _, ok := r.unreachable[m.Id]; ok {
+			continue
+		}
+
+		if m.Id == self {
+			partition.Self = m
+		}
+
+		partition.Reachable = append(partition.Reachable, m)
+	}
+
+	for _, m := range r.unreachable {
+		partition.Unreachable = append(partition.Unreachable, m)
+	}
+
+	r.unreachable = make(map[string]*Member)
+	r.mu.Unlock()
+
+	if partition.Self == nil {
+		// the local member is not part of the topology (yet), it has nothing to decide
+		return
+	}
+
+	r.resolve(partition)
+}
+
+func (r *splitBrainResolver) resolve(partition *Partition) {
+	survived := r.strategy.Survives(partition)
+
+	unreachable := make([]string, 0, len(partition.Unreachable))
+	for _, m := range partition.Unreachable {
+		unreachable = append(unreachable, m.Id)
+	}
+
+	r.cluster.ActorSystem.EventStream.Publish(&SplitBrainDecision{
+		Strategy:    r.strategy.Name(),
+		Survived:    survived,
+		Reachable:   partition.Reachable,
+		Unreachable: partition.Unreachable,
+	})
+
+	if !survived {
+		r.cluster.Logger().Warn("Split brain resolver downing the local member",
+			slog.String("strategy", r.strategy.Name()),
+			slog.Int("reachable", len(partition.Reachable)),
+			slog.String("unreachable", strings.Join(unreachable, ",")))
+
+		go func() {
+			if err := r.cluster.Shutdown(context.Background(), false); err != nil {
+				r.cluster.Logger().Error("Split brain resolver failed to down the local member", slog.Any("error", err))
+			}
+		}()
+
+		return
+	}
+
+	r.cluster.Logger().Warn("Split brain resolver downing unreachable members",
+		slog.String("strategy", r.strategy.Name()),
+		slog.String("members", strings.Join(unreachable, ",")))
+
+	r.mu.Lock()
+	for _, id := range unreachable {
+		r.downed[id] = struct{}{}
+	}
+	r.mu.Unlock()
+
+	r.cluster.block(BlockReasonSplitBrain, unreachable...)
+	r.cluster.MemberList.refreshTopology()
+}
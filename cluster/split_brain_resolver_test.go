@@ -0,0 +1,97 @@
+package cluster
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func splitMembers(ports ...int) Members {
+	members := make(Members, 0, len(ports))
+	for _, port := range ports {
+		members = append(members, &Member{Host: "127.0.0.1", Port: int32(port), Id: fmt.Sprintf("member-%d", port)})
+	}
+
+	return members
+}
+
+func partitionOf(reachable, unreachable Members) *Partition {
+	return &Partition{
+		Self:        reachable[0],
+		Reachable:   reachable,
+		Unreachable: unreachable,
+		StartedAt:   map[string]time.Time{},
+	}
+}
+
+func TestKeepMajority(t *testing.T) {
+	majority, minority := splitMembers(1, 2, 3), splitMembers(4, 5)
+
+	assert.True(t, KeepMajority().Survives(partitionOf(majority, minority)))
+	assert.False(t, KeepMajority().Survives(partitionOf(minority, majority)))
+
+	// on a tie the side with the lowest address survives
+	lowest, highest := splitMembers(1, 4), splitMembers(2, 3)
+	assert.True(t, KeepMajority().Survives(partitionOf(lowest, highest)))
+	assert.False(t, KeepMajority().Survives(partitionOf(highest, lowest)))
+}
+
+func TestStaticQuorum(t *testing.T) {
+	quorum := StaticQuorum(3)
+
+	assert.True(t, quorum.Survives(partitionOf(splitMembers(1, 2, 3), splitMembers(4, 5))))
+	assert.False(t, quorum.Survives(partitionOf(splitMembers(4, 5), splitMembers(1, 2, 3))))
+	// a partition in three sides downs all of them
+	assert.False(t, quorum.Survives(partitionOf(splitMembers(1, 2), splitMembers(3, 4, 5))))
+}
+
+func TestKeepOldest(t *testing.T) {
+	first, second := splitMembers(1, 2), splitMembers(3, 4, 5)
+	now := time.Now()
+
+	partition := partitionOf(first, second)
+	partition.StartedAt = map[string]time.Time{
+		second[0].Id: now.Add(-time.Hour),
+		first[0].Id:  now.Add(-time.Minute),
+	}
+	assert.False(t, KeepOldest(false).Survives(partition))
+
+	partition = partitionOf(second, first)
+	partition.StartedAt = map[string]time.Time{
+		second[0].Id: now.Add(-time.Hour),
+		first[0].Id:  now.Add(-time.Minute),
+	}
+	assert.True(t, KeepOldest(false).Survives(partition))
+
+	// members without a known start are the youngest
+	partition = partitionOf(first, second)
+	partition.StartedAt = map[string]time.Time{first[1].Id: now}
+	assert.True(t, KeepOldest(false).Survives(partition))
+}
+
+func TestKeepOldest_DownIfAlone(t *testing.T) {
+	alone, others := splitMembers(1), splitMembers(2, 3)
+	startedAt := map[string]time.Time{alone[0].Id: time.Now().Add(-time.Hour), others[0].Id: time.Now()}
+
+	partition := partitionOf(alone, others)
+	partition.StartedAt = startedAt
+	assert.True(t, KeepOldest(false).Survives(partition))
+	assert.False(t, KeepOldest(true).Survives(partition))
+
+	partition = partitionOf(others, alone)
+	partition.StartedAt = startedAt
+	assert.False(t, KeepOldest(false).Survives(partition))
+	assert.True(t, KeepOldest(true).Survives(partition))
+}
+
+func TestKeepReferee(t *testing.T) {
+	withReferee, withoutReferee := splitMembers(1, 2), splitMembers(3, 4, 5)
+	referee := KeepReferee(withReferee[1].Address(), 2)
+
+	assert.True(t, referee.Survives(partitionOf(withReferee, withoutReferee)))
+	assert.False(t, referee.Survives(partitionOf(withoutReferee, withReferee)))
+	// the referee side is downed when it has too few members
+	assert.False(t, KeepReferee(withReferee[1].Address(), 3).Survives(partitionOf(withReferee, withoutReferee)))
+}
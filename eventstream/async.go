@@ -0,0 +1,102 @@
+package eventstream
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what an asynchronous subscription does with an event when its buffer is full
+type OverflowPolicy int32
+
+const (
+	// DropNewest discards the event being published
+	DropNewest OverflowPolicy = iota
+	// DropOldest discards the oldest buffered event to make room for the event being published
+	DropOldest
+	// Block makes the publisher wait until the subscriber has room in its buffer
+	Block
+)
+
+// SubscriptionOption configures a Subscription
+type SubscriptionOption func(sub *Subscription)
+
+// WithAsync delivers events to the handler on a dedicated goroutine through a buffer of bufferSize events,
+// so that a slow handler does not stall the publisher. Events that do not fit into the buffer are handled
+// according to the overflow policy and counted by Subscription.Dropped.
+func WithAsync(bufferSize int, policy OverflowPolicy) SubscriptionOption {
+	return func(sub *Subscription) {
+		if bufferSize < 1 {
+			bufferSize = 1
+		}
+
+		sub.async = &asyncDelivery{
+			events: make(chan interface{}, bufferSize),
+			done:   make(chan struct{}),
+			policy: policy,
+		}
+	}
+}
+
+type asyncDelivery struct {
+	events   chan interface{}
+	done     chan struct{}
+	stopOnce sync.Once
+	policy   OverflowPolicy
+	dropped  uint64
+	// serializes DropOldest publishers so that the evicted slot is not taken by another publisher
+	mu sync.Mutex
+}
+
+func (a *asyncDelivery) enqueue(evt interface{}) {
+	select {
+	case a.events <- evt:
+		return
+	case <-a.done:
+		return
+	default:
+	}
+
+	switch a.policy {
+	case Block:
+		select {
+		case a.events <- evt:
+		case <-a.done:
+		}
+	case DropOldest:
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		for {
+			select {
+			case a.events <- evt:
+				return
+			default:
+			}
+
+			select {
+			case <-a.events:
+				atomic.AddUint64(&a.dropped, 1)
+			default:
+			}
+		}
+	default:
+		atomic.AddUint64(&a.dropped, 1)
+	}
+}
+
+func (a *asyncDelivery) run(handler Handler) {
+	for {
+		select {
+		case evt := <-a.events:
+			handler(evt)
+		case <-a.done:
+			return
+		}
+	}
+}
+
+func (a *asyncDelivery) stop() {
+	a.stopOnce.Do(func() {
+		close(a.done)
+	})
+}
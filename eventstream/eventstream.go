@@ -31,12 +31,20 @@ func NewEventStream() *EventStream {
 }
 
 // Subscribe the given handler to the EventStream
-func (es *EventStream) Subscribe(handler Handler) *Subscription {
+func (es *EventStream) Subscribe(handler Handler, opts ...SubscriptionOption) *Subscription {
 	sub := &Subscription{
 		handler: handler,
 		active:  1,
 	}
 
+	for _, opt := range opts {
+		opt(sub)
+	}
+
+	if sub.async != nil {
+		go sub.async.run(handler)
+	}
+
 	es.Lock()
 	defer es.Unlock()
 
@@ -49,13 +57,22 @@ func (es *EventStream) Subscribe(handler Handler) *Subscription {
 
 // SubscribeWithPredicate creates a new Subscription value and sets a predicate to filter messages passed to
 // the subscriber, it returns a pointer to the Subscription value
-func (es *EventStream) SubscribeWithPredicate(handler Handler, p Predicate) *Subscription {
-	sub := es.Subscribe(handler)
+func (es *EventStream) SubscribeWithPredicate(handler Handler, p Predicate, opts ...SubscriptionOption) *Subscription {
+	sub := es.Subscribe(handler, opts...)
 	sub.p = p
 
 	return sub
 }
 
+// SubscribeTo subscribes a handler to the events of type T published to the EventStream
+func SubscribeTo[T any](es *EventStream, handler func(evt T), opts ...SubscriptionOption) *Subscription {
+	return es.Subscribe(func(evt interface{}) {
+		if typed, ok := evt.(T); ok {
+			handler(typed)
+		}
+	}, opts...)
+}
+
 // Unsubscribes the given subscription from the EventStream
 func (es *EventStream) Unsubscribe(sub *Subscription) {
 	if sub == nil {
@@ -67,6 +84,10 @@ func (es *EventStream) Unsubscribe(sub *Subscription) {
 		defer es.Unlock()
 
 		if sub.Deactivate() {
+			if sub.async != nil {
+				sub.async.stop()
+			}
+
 			if es.counter == 0 {
 				es.subscriptions = nil
 
@@ -105,7 +126,11 @@ func (es *EventStream) Publish(evt interface{}) {
 		}
 
 		// finally here, lets execute our handler
-		sub.handler(evt)
+		if sub.async != nil {
+			sub.async.enqueue(evt)
+		} else {
+			sub.handler(evt)
+		}
 	}
 }
 
@@ -124,6 +149,16 @@ type Subscription struct {
 	handler Handler
 	p       Predicate
 	active  uint32
+	async   *asyncDelivery
+}
+
+// Dropped returns the number of events an asynchronous subscription discarded because its buffer was full
+func (s *Subscription) Dropped() uint64 {
+	if s.async == nil {
+		return 0
+	}
+
+	return atomic.LoadUint64(&s.async.dropped)
 }
 
 // Activates the Subscription setting its active flag as 1, if the subscription
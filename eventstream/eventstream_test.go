@@ -2,6 +2,7 @@ package eventstream_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/asynkron/protoactor-go/eventstream"
 	"github.com/stretchr/testify/assert"
@@ -99,3 +100,74 @@ func BenchmarkEventStream(b *testing.B) {
 		}
 	}
 }
+
+func TestEventStream_SubscribeTo(t *testing.T) {
+	es := &eventstream.EventStream{}
+
+	var received []int
+	eventstream.SubscribeTo(es, func(evt int) { received = append(received, evt) })
+
+	es.Publish(1)
+	es.Publish("ignored")
+	es.Publish(2)
+
+	assert.Equal(t, []int{1, 2}, received)
+}
+
+func TestEventStream_SubscribeAsync_DoesNotBlockPublisher(t *testing.T) {
+	es := &eventstream.EventStream{}
+
+	release := make(chan struct{})
+	received := make(chan interface{}, 10)
+	sub := es.Subscribe(func(evt interface{}) {
+		<-release
+		received <- evt
+	}, eventstream.WithAsync(2, eventstream.DropNewest))
+
+	published := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			es.Publish(i)
+		}
+		close(published)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		assert.Fail(t, "publisher was blocked by a slow subscriber")
+	}
+
+	close(release)
+	assert.Equal(t, 0, <-received)
+	assert.Greater(t, sub.Dropped(), uint64(0))
+	es.Unsubscribe(sub)
+}
+
+func TestEventStream_SubscribeAsync_DropOldest(t *testing.T) {
+	es := &eventstream.EventStream{}
+
+	block := make(chan struct{})
+	received := make(chan interface{}, 10)
+	sub := es.Subscribe(func(evt interface{}) {
+		if evt == "block" {
+			<-block
+			return
+		}
+		received <- evt
+	}, eventstream.WithAsync(2, eventstream.DropOldest))
+	defer es.Unsubscribe(sub)
+
+	es.Publish("block")
+	// wait until the handler picked the blocking event from the buffer
+	time.Sleep(50 * time.Millisecond)
+
+	for i := 0; i < 5; i++ {
+		es.Publish(i)
+	}
+	close(block)
+
+	assert.Equal(t, 3, <-received)
+	assert.Equal(t, 4, <-received)
+	assert.Equal(t, uint64(3), sub.Dropped())
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"cluster-basic/shared"
+	"context"
 	"fmt"
 	console "github.com/asynkron/goconsole"
 	"github.com/asynkron/protoactor-go/actor"
@@ -23,7 +24,7 @@ func main() {
 
 	fmt.Println()
 	console.ReadLine()
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
 
 func startNode() *cluster.Cluster {
@@ -34,7 +35,9 @@ func startNode() *cluster.Cluster {
 	config := remote.Configure("localhost", 0)
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, config)
 	c := cluster.New(system, clusterConfig)
-	c.StartMember()
+	if err := c.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	return c
 }
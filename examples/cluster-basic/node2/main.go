@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"cluster-basic/shared"
@@ -19,7 +20,7 @@ func main() {
 	fmt.Print("\nBoot other nodes and press Enter\n")
 	console.ReadLine()
 
-	cluster.Shutdown(true)
+	cluster.Shutdown(context.Background(), true)
 }
 
 func startNode() *cluster.Cluster {
@@ -42,6 +43,8 @@ func startNode() *cluster.Cluster {
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, config, cluster.WithKinds(helloKind))
 	c := cluster.New(system, clusterConfig)
 
-	c.StartMember()
+	if err := c.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 	return c
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -48,7 +49,7 @@ func main() {
 
 	console.ReadLine()
 
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
 
 func startNode(port int64) *cluster.Cluster {
@@ -76,7 +77,9 @@ func startNode(port int64) *cluster.Cluster {
 		return &shared.TrackGrain{}
 	})
 
-	cluster.StartMember()
+	if err := cluster.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	return cluster
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -42,7 +43,7 @@ func main() {
 
 	console.ReadLine()
 
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
 
 func startNode(port int64) *cluster.Cluster {
@@ -65,7 +66,9 @@ func startNode(port int64) *cluster.Cluster {
 
 	cluster := cluster.New(system, clusterConfig)
 
-	cluster.StartMember()
+	if err := cluster.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 	return cluster
 }
 
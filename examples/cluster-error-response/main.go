@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	actor "github.com/asynkron/protoactor-go/actor"
@@ -19,7 +20,9 @@ func main() {
 	clusterConfig := cluster.Configure("test", provider, lookup, config, cluster.WithKinds(
 		helloKind))
 	cst := cluster.New(system, clusterConfig)
-	cst.StartMember()
+	if err := cst.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	client := GetHelloGrainClient(cst, "test")
 	_, err := client.Hello(&HelloRequest{Name: "user-not-found"})
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"strings"
@@ -29,7 +30,7 @@ func main() {
 	cancelPublisher()
 	cancelSubscriber()
 
-	cluster.Shutdown(true)
+	cluster.Shutdown(context.Background(), true)
 }
 
 func startNode(remotingPort int, clusteringPort int, clusterMembers []string) *cluster.Cluster {
@@ -42,7 +43,9 @@ func startNode(remotingPort int, clusteringPort int, clusterMembers []string) *c
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, config)
 	cluster := cluster.New(system, clusterConfig)
 
-	cluster.StartMember()
+	if err := cluster.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	return cluster
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"cluster-gossip/shared"
+	"context"
 	"fmt"
 	console "github.com/asynkron/goconsole"
 	"github.com/asynkron/protoactor-go/actor"
@@ -41,7 +42,7 @@ func main() {
 
 	fmt.Println()
 	_, _ = console.ReadLine()
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
 
 func coloredConsoleLogging(system *actor.ActorSystem) *slog.Logger {
@@ -91,7 +92,9 @@ func startNode() *cluster.Cluster {
 	config := remote.Configure("localhost", 0)
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, config)
 	c := cluster.New(system, clusterConfig)
-	c.StartMember()
+	if err := c.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	return c
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/lmittmann/tint"
 	"os"
@@ -23,7 +24,7 @@ func main() {
 	fmt.Print("\nBoot other nodes and press Enter\n")
 	console.ReadLine()
 
-	cluster.Shutdown(true)
+	cluster.Shutdown(context.Background(), true)
 }
 
 func coloredConsoleLogging(system *actor.ActorSystem) *slog.Logger {
@@ -71,6 +72,8 @@ func startNode() *cluster.Cluster {
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, config, cluster.WithKinds(helloKind))
 	c := cluster.New(system, clusterConfig)
 
-	c.StartMember()
+	if err := c.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 	return c
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"cluster-grain/shared"
@@ -21,7 +22,9 @@ func main() {
 	config := remote.Configure("localhost", 0)
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, config)
 	c := cluster.New(system, clusterConfig)
-	c.StartMember()
+	if err := c.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	fmt.Print("\nBoot other nodes and press Enter\n")
 	console.ReadLine()
@@ -50,5 +53,5 @@ func main() {
 	fmt.Println()
 
 	console.ReadLine()
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
@@ -37,8 +38,10 @@ func main() {
 		cluster.WithKinds(helloKind))
 
 	c := cluster.New(system, clusterConfig)
-	c.StartMember()
+	if err := c.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 	fmt.Print("\nBoot other nodes and press Enter\n")
 	console.ReadLine()
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"time"
@@ -76,7 +77,9 @@ func main() {
 
 	clusterConfig := cluster.Configure("my-cluster", provider, lookup, remoteConfig, cluster.WithKinds(helloKind))
 	c := cluster.New(system, clusterConfig)
-	c.StartMember()
+	if err := c.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	// this node knows about Hello kind
 	hello := shared.GetHelloGrainClient(c, "MyGrain")
@@ -87,5 +90,5 @@ func main() {
 	}
 	log.Printf("Message from grain: %v", res.Message)
 	_, _ = console.ReadLine()
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
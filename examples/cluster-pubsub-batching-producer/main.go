@@ -47,7 +47,7 @@ func main() {
 	}
 	elapsed := time.Since(start)
 	producer.Dispose()
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 
 	fmt.Printf("Sent: %d, delivered: %d, msg/s %f\n", count, deliveredCount, float64(deliveredCount)/elapsed.Seconds())
 
@@ -66,7 +66,9 @@ func startNode() *cluster.Cluster {
 
 	cluster := cluster.New(system, clusterConfig)
 
-	cluster.StartMember()
+	if err := cluster.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	return cluster
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"strconv"
 
 	console "github.com/asynkron/goconsole"
@@ -19,7 +20,7 @@ func main() {
 	}
 
 	console.ReadLine()
-	c.Shutdown(true)
+	c.Shutdown(context.Background(), true)
 }
 
 func startNode() *cluster.Cluster {
@@ -39,7 +40,9 @@ func startNode() *cluster.Cluster {
 
 	cluster := cluster.New(system, clusterConfig)
 
-	cluster.StartMember()
+	if err := cluster.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 
 	return cluster
 }
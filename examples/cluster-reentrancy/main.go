@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	fmt "fmt"
 
 	actor "github.com/asynkron/protoactor-go/actor"
@@ -19,7 +20,9 @@ func main() {
 	clusterConfig := cluster.Configure("core", provider, lookup, config, cluster.WithKinds(
 		helloKind))
 	cst := cluster.New(system, clusterConfig)
-	cst.StartMember()
+	if err := cst.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 	// self call: request -> 1 -> 1
 	client := GetHelloGrainClient(cst, "1")
 	resp, err := client.InvokeService(&InvokeServiceRequest{Name: "Alice"})
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"github.com/asynkron/protoactor-go/cluster/identitylookup/disthash"
@@ -45,7 +46,7 @@ func main() {
 		}
 	}
 	plog.Info("shutdown ...")
-	_cluster.Shutdown(true)
+	_cluster.Shutdown(context.Background(), true)
 	plog.Info("shutdown OK")
 }
 
@@ -71,7 +72,9 @@ func startNode(port int, provider string) {
 	remoteCfg := remote.Configure("127.0.0.1", port)
 	cfg := cluster.Configure("cluster-restartgracefully", cp, id, remoteCfg)
 	_cluster = cluster.New(system, cfg)
-	_cluster.StartClient()
+	if err := _cluster.StartClient(context.Background()); err != nil {
+		panic(err)
+	}
 }
 
 func runClientsAll(clients int, loops int, interval time.Duration) {
@@ -104,7 +107,7 @@ func runClient(grainId string, loops int, interval time.Duration) {
 	calcGrain := shared.GetCalculatorGrainClient(_cluster, grainId)
 	resp, err := calcGrain.GetCurrent(&shared.Void{}, cluster.WithRetryCount(3), cluster.WithTimeout(6*time.Second))
 	if err != nil {
-		_cluster.Shutdown(true)
+		_cluster.Shutdown(context.Background(), true)
 		panic(err)
 	}
 	baseNumber := resp.Number
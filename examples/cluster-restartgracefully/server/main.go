@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log/slog"
@@ -40,7 +41,7 @@ func main() {
 		switch sig {
 		case syscall.SIGINT:
 			plog.Info("Shutdown...")
-			_cluster.Shutdown(true)
+			_cluster.Shutdown(context.Background(), true)
 			plog.Info("Shutdown ok")
 			time.Sleep(time.Second)
 			os.Exit(0)
@@ -76,5 +77,7 @@ func startNode(port int, provider string, timeout time.Duration) {
 	remoteCfg := remote.Configure("127.0.0.1", port)
 	cfg := cluster.Configure("cluster-restartgracefully", cp, id, remoteCfg, cluster.WithKinds(shared.GetCalculatorKind()))
 	_cluster = cluster.New(system, cfg)
-	_cluster.StartMember()
+	if err := _cluster.StartMember(context.Background()); err != nil {
+		panic(err)
+	}
 }
@@ -40,6 +40,11 @@ type ActorMetrics struct {
 	ThreadPoolLatency metric.Int64Histogram
 }
 
+// protoMeter returns the meter the instruments of Proto.Actor are created with
+func protoMeter() metric.Meter {
+	return otel.Meter(LibName)
+}
+
 // NewActorMetrics creates a new ActorMetrics value and returns a pointer to it
 func NewActorMetrics(logger *slog.Logger) *ActorMetrics {
 	instruments := newInstruments(logger)
@@ -49,7 +54,7 @@ func NewActorMetrics(logger *slog.Logger) *ActorMetrics {
 // newInstruments will create instruments using a meter from
 // the given provider p
 func newInstruments(logger *slog.Logger) *ActorMetrics {
-	meter := otel.Meter(LibName)
+	meter := protoMeter()
 	instruments := ActorMetrics{mu: &sync.Mutex{}}
 
 	var err error
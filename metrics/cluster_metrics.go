@@ -0,0 +1,89 @@
+// Copyright (C) 2017 - 2024 Asynkron.se <http://www.asynkron.se>
+
+package metrics
+
+import (
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+type ClusterMetrics struct {
+	// Requests
+	ClusterRequestDurationHistogram metric.Float64Histogram
+	ClusterRequestRetryCount        metric.Int64Counter
+	ClusterRequestFailureCount      metric.Int64Counter
+
+	// PID cache
+	ClusterPidCacheHitCount  metric.Int64Counter
+	ClusterPidCacheMissCount metric.Int64Counter
+
+	// Identity lookup
+	ClusterIdentityLookupDurationHistogram metric.Float64Histogram
+}
+
+// NewClusterMetrics creates a new ClusterMetrics value and returns a pointer to it, the instruments are created with
+// the meter of the ActorMetrics
+func NewClusterMetrics(logger *slog.Logger) *ClusterMetrics {
+	meter := protoMeter()
+	instruments := ClusterMetrics{}
+
+	var err error
+
+	if instruments.ClusterRequestDurationHistogram, err = meter.Float64Histogram(
+		"protoactor_cluster_request_duration_seconds",
+		metric.WithDescription("Cluster requests duration in seconds, retries included"),
+		metric.WithUnit("s"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterRequestDurationHistogram instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.ClusterRequestRetryCount, err = meter.Int64Counter(
+		"protoactor_cluster_request_retry_count",
+		metric.WithDescription("Number of cluster request retries"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterRequestRetryCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.ClusterRequestFailureCount, err = meter.Int64Counter(
+		"protoactor_cluster_request_failure_count",
+		metric.WithDescription("Number of failed cluster requests by reason"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterRequestFailureCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.ClusterPidCacheHitCount, err = meter.Int64Counter(
+		"protoactor_cluster_pid_cache_hit_count",
+		metric.WithDescription("Number of cluster requests resolving the PID of the grain from the PID cache"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterPidCacheHitCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.ClusterPidCacheMissCount, err = meter.Int64Counter(
+		"protoactor_cluster_pid_cache_miss_count",
+		metric.WithDescription("Number of cluster requests resolving the PID of the grain through the identity lookup"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterPidCacheMissCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.ClusterIdentityLookupDurationHistogram, err = meter.Float64Histogram(
+		"protoactor_cluster_identity_lookup_duration_seconds",
+		metric.WithDescription("Identity lookups duration in seconds"),
+		metric.WithUnit("s"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterIdentityLookupDurationHistogram instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	return &instruments
+}
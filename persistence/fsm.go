@@ -0,0 +1,16 @@
+package persistence
+
+import (
+	"github.com/asynkron/protoactor-go/actor"
+	"google.golang.org/protobuf/proto"
+)
+
+// PersistTransitions persists the event created by the given function for every transition of the FSM made
+// outside of recovery. The actor restores the FSM from the replayed events with actor.FSM.Restore.
+func PersistTransitions[S comparable, D any](mixin *Mixin, fsm *actor.FSM[S, D], event func(transition *actor.FSMTransition[S, D]) proto.Message) {
+	fsm.OnTransition(func(transition *actor.FSMTransition[S, D]) {
+		if !mixin.Recovering() {
+			mixin.PersistReceive(event(transition))
+		}
+	})
+}
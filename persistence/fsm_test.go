@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type fsmActor struct {
+	Mixin
+	fsm *actor.FSM[string, string]
+}
+
+func newFSMActor() actor.Actor {
+	a := &fsmActor{}
+	a.fsm = actor.NewFSM[string, string]("idle", "").
+		When("idle", func(ctx actor.Context, data string) (string, string) {
+			if msg, ok := ctx.Message().(*Query); ok && msg.state == "start" {
+				return "running", "started"
+			}
+			return "idle", data
+		}).
+		When("running", func(ctx actor.Context, data string) (string, string) {
+			return "running", data
+		})
+
+	PersistTransitions(&a.Mixin, a.fsm, func(transition *actor.FSMTransition[string, string]) proto.Message {
+		return newMessage(transition.To)
+	})
+
+	return a
+}
+
+func (a *fsmActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *Message:
+		// replayed transition
+		a.fsm.Restore(msg.state, "restored")
+	case *Query:
+		if msg.state == "state" {
+			ctx.Respond(a.fsm.State())
+			return
+		}
+		a.fsm.Receive(ctx)
+	default:
+		a.fsm.Receive(ctx)
+	}
+}
+
+func TestPersistTransitions(t *testing.T) {
+	system := actor.NewActorSystem()
+	provider := &dataStore{providerState: NewInMemoryProvider(100)}
+	props := actor.PropsFromProducer(newFSMActor, actor.WithReceiverMiddleware(Using(provider)))
+
+	pid, err := system.Root.SpawnNamed(props, "fsm")
+	require.NoError(t, err)
+
+	system.Root.Send(pid, &Query{protoMsg: protoMsg{state: "start"}})
+	state, err := system.Root.RequestFuture(pid, &Query{protoMsg: protoMsg{state: "state"}}, time.Second).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "running", state)
+
+	require.NoError(t, system.Root.StopFuture(pid).Wait())
+
+	// the transition is replayed into a new incarnation
+	pid, err = system.Root.SpawnNamed(props, "fsm")
+	require.NoError(t, err)
+	state, err = system.Root.RequestFuture(pid, &Query{protoMsg: protoMsg{state: "state"}}, time.Second).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "running", state)
+}
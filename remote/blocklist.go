@@ -5,48 +5,269 @@
 package remote
 
 import (
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/asynkron/gofun/set"
 )
 
-// TODO: document it
+// BlockEntry describes why a member is blocked and for how long
+type BlockEntry struct {
+	MemberID  string
+	Reason    string
+	BlockedAt time.Time
+	TTL       time.Duration // 0 blocks the member until it is unblocked
+}
+
+// ExpiresAt returns when the entry expires, the zero time if it never does
+func (e BlockEntry) ExpiresAt() time.Time {
+	if e.TTL <= 0 {
+		return time.Time{}
+	}
+
+	return e.BlockedAt.Add(e.TTL)
+}
+
+func (e BlockEntry) expired(now time.Time) bool {
+	return e.TTL > 0 && !now.Before(e.BlockedAt.Add(e.TTL))
+}
+
+// BlockList keeps the members whose connections are refused. A member stays blocked until its entry expires or
+// it is unblocked, the time of the unblock is remembered so that older blocks of the member, e.g. received through
+// gossip, are not applied again.
 type BlockList struct {
-	mu             *sync.RWMutex
-	blockedMembers *set.ImmutableSet[string]
+	mu        *sync.RWMutex
+	entries   map[string]BlockEntry
+	unblocked map[string]time.Time
+	version   uint64
 }
 
 func NewBlockList() *BlockList {
 	blocklist := BlockList{
-		mu:             &sync.RWMutex{},
-		blockedMembers: set.NewImmutable[string](),
+		mu:        &sync.RWMutex{},
+		entries:   make(map[string]BlockEntry),
+		unblocked: make(map[string]time.Time),
 	}
 	return &blocklist
 }
 
+// BlockedMembers returns the IDs of the blocked members
 func (bl *BlockList) BlockedMembers() set.Set[string] {
-	return bl.blockedMembers
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	now := time.Now()
+	blocked := set.NewImmutable[string]()
+	for id, entry := range bl.entries {
+		if !entry.expired(now) {
+			blocked = blocked.Add(id)
+		}
+	}
+
+	return blocked
 }
 
-// Block adds the given memberID list to the BlockList
+// Block adds the given memberID list to the BlockList, without a reason and until they are unblocked
 func (bl *BlockList) Block(memberIDs ...string) {
+	bl.BlockWithReason("", 0, memberIDs...)
+}
+
+// BlockWithReason adds the given memberID list to the BlockList for ttl, 0 blocks them until they are unblocked.
+// The entries of members already blocked are kept.
+func (bl *BlockList) BlockWithReason(reason string, ttl time.Duration, memberIDs ...string) {
 	// acquire our mutual exclusion primitive
 	bl.mu.Lock()
 	defer bl.mu.Unlock()
 
-	bl.blockedMembers = bl.blockedMembers.AddRange(memberIDs...)
+	now := time.Now()
+	for _, id := range memberIDs {
+		if entry, ok := bl.entries[id]; ok && !entry.expired(now) {
+			continue
+		}
+
+		bl.entries[id] = BlockEntry{MemberID: id, Reason: reason, BlockedAt: now, TTL: ttl}
+		bl.version++
+	}
+}
+
+// Unblock removes the given memberID list from the BlockList, so that they can connect again
+func (bl *BlockList) Unblock(memberIDs ...string) {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	now := time.Now()
+	for _, id := range memberIDs {
+		delete(bl.entries, id)
+		bl.unblocked[id] = now
+		bl.version++
+	}
+}
+
+// RemoveExpired removes the entries whose ttl passed, their expiry is remembered as the time the members were
+// unblocked. It returns the IDs of the members whose entry expired.
+func (bl *BlockList) RemoveExpired() []string {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	now := time.Now()
+	var expired []string
+
+	for id, entry := range bl.entries {
+		if !entry.expired(now) {
+			continue
+		}
+
+		delete(bl.entries, id)
+		if last, ok := bl.unblocked[id]; !ok || entry.ExpiresAt().After(last) {
+			bl.unblocked[id] = entry.ExpiresAt()
+		}
+		bl.version++
+
+		expired = append(expired, id)
+	}
+
+	return expired
 }
 
-// IsBlocked returns true if the given memberID string has been
-// ever added to the BlockList
+// IsBlocked returns true if the given memberID string is blocked and its entry did not expire
 func (bl *BlockList) IsBlocked(memberID string) bool {
 	// acquire our mutual exclusion primitive for reading
-	return bl.blockedMembers.Contains(memberID)
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	entry, ok := bl.entries[memberID]
+
+	return ok && !entry.expired(time.Now())
+}
+
+// Entry returns the entry of the given memberID if it is blocked
+func (bl *BlockList) Entry(memberID string) (BlockEntry, bool) {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	entry, ok := bl.entries[memberID]
+	if !ok || entry.expired(time.Now()) {
+		return BlockEntry{}, false
+	}
+
+	return entry, true
+}
+
+// Entries returns the entries of the blocked members, ordered by member ID
+func (bl *BlockList) Entries() []BlockEntry {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	now := time.Now()
+	entries := make([]BlockEntry, 0, len(bl.entries))
+	for _, entry := range bl.entries {
+		if !entry.expired(now) {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].MemberID < entries[j].MemberID
+	})
+
+	return entries
+}
+
+// Unblocked returns when the members were last unblocked, keyed by member ID
+func (bl *BlockList) Unblocked() map[string]time.Time {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	unblocked := make(map[string]time.Time, len(bl.unblocked))
+	for id, t := range bl.unblocked {
+		unblocked[id] = t
+	}
+
+	return unblocked
+}
+
+// UnblockedAt returns when the given memberID was last unblocked or its entry expired
+func (bl *BlockList) UnblockedAt(memberID string) (time.Time, bool) {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	if entry, ok := bl.entries[memberID]; ok {
+		if entry.expired(time.Now()) {
+			return entry.ExpiresAt(), true
+		}
+
+		return time.Time{}, false
+	}
+
+	t, ok := bl.unblocked[memberID]
+
+	return t, ok
+}
+
+// Merge applies the entries and unblocks known by another member, whichever happened last wins. It returns the
+// IDs of the members the merge unblocked.
+func (bl *BlockList) Merge(entries []BlockEntry, unblocked map[string]time.Time) []string {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	now := time.Now()
+	var unblockedIDs []string
+
+	for id, t := range unblocked {
+		if last, ok := bl.unblocked[id]; ok && !t.After(last) {
+			continue
+		}
+
+		bl.unblocked[id] = t
+		bl.version++
+
+		if entry, ok := bl.entries[id]; ok && entry.BlockedAt.Before(t) {
+			delete(bl.entries, id)
+			unblockedIDs = append(unblockedIDs, id)
+		}
+	}
+
+	for _, entry := range entries {
+		if entry.expired(now) {
+			continue
+		}
+
+		if t, ok := bl.unblocked[entry.MemberID]; ok && !entry.BlockedAt.After(t) {
+			continue
+		}
+
+		if current, ok := bl.entries[entry.MemberID]; ok && !current.expired(now) {
+			continue
+		}
+
+		bl.entries[entry.MemberID] = entry
+		bl.version++
+	}
+
+	return unblockedIDs
+}
+
+// Version returns a number that changes whenever the BlockList changes
+func (bl *BlockList) Version() uint64 {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	return bl.version
 }
 
 // Len returns the number of blocked members
 func (bl *BlockList) Len() int {
 	bl.mu.RLock()
 	defer bl.mu.RUnlock()
-	return bl.blockedMembers.Size()
+
+	now := time.Now()
+	n := 0
+	for _, entry := range bl.entries {
+		if !entry.expired(now) {
+			n++
+		}
+	}
+
+	return n
 }
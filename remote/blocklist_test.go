@@ -0,0 +1,110 @@
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockList_BlockAndUnblock(t *testing.T) {
+	bl := NewBlockList()
+
+	bl.BlockWithReason("heartbeat expired", 0, "a", "b")
+	assert.True(t, bl.IsBlocked("a"))
+	assert.Equal(t, 2, bl.Len())
+
+	entry, ok := bl.Entry("a")
+	assert.True(t, ok)
+	assert.Equal(t, "heartbeat expired", entry.Reason)
+	assert.True(t, entry.ExpiresAt().IsZero())
+
+	// the entry of a member already blocked is kept
+	bl.BlockWithReason("other", 0, "a")
+	entry, _ = bl.Entry("a")
+	assert.Equal(t, "heartbeat expired", entry.Reason)
+
+	bl.Unblock("a")
+	assert.False(t, bl.IsBlocked("a"))
+	assert.True(t, bl.IsBlocked("b"))
+	assert.False(t, bl.BlockedMembers().Contains("a"))
+
+	_, ok = bl.UnblockedAt("a")
+	assert.True(t, ok)
+}
+
+func TestBlockList_TTL(t *testing.T) {
+	bl := NewBlockList()
+
+	bl.BlockWithReason("reason", 20*time.Millisecond, "a")
+	assert.True(t, bl.IsBlocked("a"))
+
+	_, ok := bl.UnblockedAt("a")
+	assert.False(t, ok)
+
+	time.Sleep(30 * time.Millisecond)
+
+	assert.False(t, bl.IsBlocked("a"))
+	assert.Zero(t, bl.Len())
+	assert.Empty(t, bl.Entries())
+
+	_, ok = bl.UnblockedAt("a")
+	assert.True(t, ok)
+
+	// an expired member can be blocked again
+	bl.Block("a")
+	assert.True(t, bl.IsBlocked("a"))
+}
+
+func TestBlockList_RemoveExpired(t *testing.T) {
+	bl := NewBlockList()
+
+	bl.BlockWithReason("reason", 20*time.Millisecond, "a")
+	bl.Block("b")
+	assert.Empty(t, bl.RemoveExpired())
+
+	time.Sleep(30 * time.Millisecond)
+
+	version := bl.Version()
+	assert.Equal(t, []string{"a"}, bl.RemoveExpired())
+	assert.Greater(t, bl.Version(), version)
+	assert.True(t, bl.IsBlocked("b"))
+
+	// the expiry is remembered as the unblock of the member, so that it is gossiped
+	unblockedAt, ok := bl.Unblocked()["a"]
+	assert.True(t, ok)
+	assert.False(t, unblockedAt.After(time.Now()))
+
+	version = bl.Version()
+	assert.Empty(t, bl.RemoveExpired())
+	assert.Equal(t, version, bl.Version())
+}
+
+func TestBlockList_Merge(t *testing.T) {
+	bl := NewBlockList()
+	bl.Block("a")
+	version := bl.Version()
+
+	now := time.Now()
+	unblocked := bl.Merge(
+		[]BlockEntry{
+			{MemberID: "b", Reason: "remote", BlockedAt: now},
+			{MemberID: "c", Reason: "expired", BlockedAt: now.Add(-time.Minute), TTL: time.Second},
+		},
+		map[string]time.Time{"a": now.Add(time.Millisecond)},
+	)
+
+	assert.Equal(t, []string{"a"}, unblocked)
+	assert.False(t, bl.IsBlocked("a"))
+	assert.True(t, bl.IsBlocked("b"))
+	assert.False(t, bl.IsBlocked("c"))
+	assert.NotEqual(t, version, bl.Version())
+
+	// a block older than the unblock is not applied again
+	bl.Merge([]BlockEntry{{MemberID: "a", BlockedAt: now}}, nil)
+	assert.False(t, bl.IsBlocked("a"))
+
+	// a newer block is
+	bl.Merge([]BlockEntry{{MemberID: "a", BlockedAt: now.Add(time.Second)}}, nil)
+	assert.True(t, bl.IsBlocked("a"))
+}
@@ -126,7 +126,7 @@ func (em *endpointManager) stop() {
 
 func (em *endpointManager) startActivator() {
 	p := newActivatorActor(em.remote)
-	props := actor.PropsFromProducer(p, actor.WithGuardian(actor.RestartingSupervisorStrategy()))
+	props := actor.PropsFromProducer(p, actor.WithGuardian(actor.RestartingSupervisorStrategy()), actor.WithSystemActor())
 	pid, err := em.remote.actorSystem.Root.SpawnNamed(props, "activator")
 	if err != nil {
 		panic(err)
@@ -145,7 +145,8 @@ func (em *endpointManager) startSupervisor() {
 	},
 		actor.WithGuardian(actor.RestartingSupervisorStrategy()),
 		actor.WithSupervisor(actor.RestartingSupervisorStrategy()),
-		actor.WithDispatcher(actor.NewSynchronizedDispatcher(300)))
+		actor.WithDispatcher(actor.NewSynchronizedDispatcher(300)),
+		actor.WithSystemActor())
 
 	pid, err := r.actorSystem.Root.SpawnNamed(props, "EndpointSupervisor")
 	if err != nil {
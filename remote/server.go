@@ -4,6 +4,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/asynkron/protoactor-go/extensions"
@@ -26,6 +27,8 @@ type Remote struct {
 	kinds        map[string]*actor.Props
 	activatorPid *actor.PID
 	blocklist    *BlockList
+	started      atomic.Bool
+	stopped      atomic.Bool
 }
 
 func NewRemote(actorSystem *actor.ActorSystem, config *Config) *Remote {
@@ -51,6 +54,13 @@ func GetRemote(actorSystem *actor.ActorSystem) *Remote {
 	return r.(*Remote)
 }
 
+// TryGetRemote returns the Remote registered with the actor system, if any
+func TryGetRemote(actorSystem *actor.ActorSystem) (*Remote, bool) {
+	r, ok := actorSystem.Extensions.Get(extensionId).(*Remote)
+
+	return r, ok
+}
+
 func (r *Remote) ExtensionID() extensions.ExtensionID {
 	return extensionId
 }
@@ -97,9 +107,30 @@ func (r *Remote) Start() {
 	}
 	r.s = srv
 	go srv.Serve(l)
+	r.started.Store(true)
+
+	shutdown := r.actorSystem.CoordinatedShutdown()
+	shutdown.AddTask(actor.ShutdownPhaseStopAccepting, "remote-suspend", 0, func(_ context.Context) error {
+		r.edpReader.suspend(true)
+		return nil
+	})
+	shutdown.AddTask(actor.ShutdownPhaseStopRemote, "remote-shutdown", 0, func(_ context.Context) error {
+		r.Shutdown(true)
+		return nil
+	})
 }
 
+// Started returns true once the remote server is started and until it is shut down
+func (r *Remote) Started() bool {
+	return r.started.Load() && !r.stopped.Load()
+}
+
+// Shutdown stops the remote server, only the first call has any effect
 func (r *Remote) Shutdown(graceful bool) {
+	if !r.stopped.CompareAndSwap(false, true) {
+		return
+	}
+
 	if graceful {
 		// TODO: need more graceful
 		r.edpReader.suspend(true)
@@ -0,0 +1,256 @@
+package router
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// adaptiveResponseTimeout is how long a request is considered in flight when its routee does not respond,
+// a later response is still forwarded to the sender
+var adaptiveResponseTimeout = 10 * time.Second
+
+// adaptiveLatencyDecay is the weight of the latest observation in the latency moving average
+const adaptiveLatencyDecay = 0.2
+
+type adaptiveGroupRouter struct {
+	GroupRouter
+}
+
+type adaptivePoolRouter struct {
+	PoolRouter
+}
+
+type adaptiveRoutee struct {
+	pid      *actor.PID
+	inFlight int64
+	// exponentially weighted moving average of the response latency in nanoseconds
+	latency int64
+}
+
+func (r *adaptiveRoutee) observe(latency time.Duration) {
+	for {
+		old := atomic.LoadInt64(&r.latency)
+		next := int64(latency)
+		if old != 0 {
+			next = int64(float64(old)*(1-adaptiveLatencyDecay) + float64(latency)*adaptiveLatencyDecay)
+		}
+
+		if atomic.CompareAndSwapInt64(&r.latency, old, next) {
+			return
+		}
+	}
+}
+
+// score estimates how long a new request would take on the routee, lower is better
+func (r *adaptiveRoutee) score() float64 {
+	return float64(atomic.LoadInt64(&r.latency)+1) * float64(atomic.LoadInt64(&r.inFlight)+1)
+}
+
+type adaptiveState struct {
+	index   int32
+	mu      sync.RWMutex
+	routees *actor.PIDSet
+	stats   []*adaptiveRoutee
+	sender  actor.SenderContext
+}
+
+func (state *adaptiveState) SetSender(sender actor.SenderContext) {
+	state.sender = sender
+}
+
+func (state *adaptiveState) SetRoutees(routees *actor.PIDSet) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	previous := make(map[string]*adaptiveRoutee, len(state.stats))
+	for _, r := range state.stats {
+		previous[r.pid.String()] = r
+	}
+
+	stats := make([]*adaptiveRoutee, 0, routees.Len())
+	routees.ForEach(func(_ int, pid *actor.PID) {
+		if r, ok := previous[pid.String()]; ok {
+			stats = append(stats, r)
+		} else {
+			stats = append(stats, &adaptiveRoutee{pid: pid})
+		}
+	})
+
+	state.routees = routees
+	state.stats = stats
+}
+
+func (state *adaptiveState) GetRoutees() *actor.PIDSet {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	return state.routees
+}
+
+func (state *adaptiveState) RouteMessage(message interface{}) {
+	routee := state.adaptiveRoutee()
+	if routee == nil {
+		state.sender.Send(nil, message)
+		return
+	}
+
+	envelope, ok := message.(*actor.MessageEnvelope)
+	if !ok || envelope.Sender == nil {
+		// fire and forget messages cannot be measured, only the in-flight requests are considered
+		state.sender.Send(routee.pid, message)
+		return
+	}
+
+	request := *envelope
+	request.Sender = newResponseObserver(state.sender.ActorSystem(), routee, envelope.Sender)
+	state.sender.Send(routee.pid, &request)
+}
+
+// adaptiveRoutee picks the routee with the lowest latency weighted by its in-flight requests
+func (state *adaptiveState) adaptiveRoutee() *adaptiveRoutee {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	if len(state.stats) == 0 {
+		return nil
+	}
+
+	offset := int(uint32(atomic.AddInt32(&state.index, 1)))
+
+	var (
+		best      *adaptiveRoutee
+		bestScore = math.MaxFloat64
+	)
+
+	for i := range state.stats {
+		r := state.stats[(offset+i)%len(state.stats)]
+		if s := r.score(); s < bestScore {
+			best, bestScore = r, s
+		}
+	}
+
+	return best
+}
+
+// responseObserver is registered as the sender of a routed request, it measures the response latency
+// of the routee and forwards the response to the original sender. It stays registered until the response
+// arrives or, once the request timed out, the local sender is gone.
+type responseObserver struct {
+	actorSystem *actor.ActorSystem
+	routee      *adaptiveRoutee
+	target      *actor.PID
+	started     time.Time
+	timeout     time.Duration
+	mu          sync.Mutex
+	timer       *time.Timer
+	released    int32
+	done        int32
+}
+
+var _ actor.Process = &responseObserver{}
+
+func newResponseObserver(actorSystem *actor.ActorSystem, routee *adaptiveRoutee, target *actor.PID) *actor.PID {
+	ref := &responseObserver{
+		actorSystem: actorSystem,
+		routee:      routee,
+		target:      target,
+		started:     time.Now(),
+		timeout:     adaptiveResponseTimeout,
+	}
+
+	pid, _ := actorSystem.ProcessRegistry.Add(ref, "adaptive"+actorSystem.ProcessRegistry.NextId())
+	atomic.AddInt64(&routee.inFlight, 1)
+
+	ref.mu.Lock()
+	ref.timer = time.AfterFunc(ref.timeout, func() {
+		ref.expire(pid)
+	})
+	ref.mu.Unlock()
+
+	return pid
+}
+
+// expire stops counting a request whose routee did not respond in time, the response is still awaited as long
+// as the sender may receive it
+func (ref *responseObserver) expire(pid *actor.PID) {
+	ref.release()
+
+	if _, ok := ref.actorSystem.ProcessRegistry.Get(ref.target); !ok {
+		ref.complete(pid)
+		return
+	}
+
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	if atomic.LoadInt32(&ref.done) == 0 {
+		ref.timer.Reset(ref.timeout)
+	}
+}
+
+// release records the latency of the request and no longer counts it in flight
+func (ref *responseObserver) release() {
+	if atomic.CompareAndSwapInt32(&ref.released, 0, 1) {
+		ref.routee.observe(time.Since(ref.started))
+		atomic.AddInt64(&ref.routee.inFlight, -1)
+	}
+}
+
+func (ref *responseObserver) complete(pid *actor.PID) bool {
+	if !atomic.CompareAndSwapInt32(&ref.done, 0, 1) {
+		return false
+	}
+
+	ref.mu.Lock()
+	ref.timer.Stop()
+	ref.mu.Unlock()
+
+	ref.actorSystem.ProcessRegistry.Remove(pid)
+	ref.release()
+
+	return true
+}
+
+func (ref *responseObserver) SendUserMessage(pid *actor.PID, message interface{}) {
+	if ref.complete(pid) {
+		target, _ := ref.actorSystem.ProcessRegistry.Get(ref.target)
+		target.SendUserMessage(ref.target, message)
+	}
+}
+
+func (ref *responseObserver) SendSystemMessage(pid *actor.PID, message interface{}) {
+	if ref.complete(pid) {
+		target, _ := ref.actorSystem.ProcessRegistry.Get(ref.target)
+		target.SendSystemMessage(ref.target, message)
+	}
+}
+
+func (ref *responseObserver) Stop(pid *actor.PID) {
+	ref.complete(pid)
+}
+
+// NewAdaptivePool creates a pool router preferring the routees with the lowest observed response latency
+// and the fewest requests in flight
+func NewAdaptivePool(size int, opts ...actor.PropsOption) *actor.Props {
+	return (&actor.Props{}).
+		Configure(actor.WithSpawnFunc(spawner(&adaptivePoolRouter{PoolRouter{PoolSize: size}}))).
+		Configure(opts...)
+}
+
+// NewAdaptiveGroup creates a group router preferring the routees with the lowest observed response latency
+// and the fewest requests in flight
+func NewAdaptiveGroup(routees ...*actor.PID) *actor.Props {
+	return (&actor.Props{}).Configure(actor.WithSpawnFunc(spawner(&adaptiveGroupRouter{GroupRouter{Routees: actor.NewPIDSet(routees...)}})))
+}
+
+func (config *adaptivePoolRouter) CreateRouterState() State {
+	return &adaptiveState{}
+}
+
+func (config *adaptiveGroupRouter) CreateRouterState() State {
+	return &adaptiveState{}
+}
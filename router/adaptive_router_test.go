@@ -0,0 +1,101 @@
+package router
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveGroup_PrefersFastRoutee(t *testing.T) {
+	var slowCount, fastCount int32
+
+	slow := system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			atomic.AddInt32(&slowCount, 1)
+			time.Sleep(20 * time.Millisecond)
+			c.Respond("slow")
+		}
+	}))
+	fast := system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			atomic.AddInt32(&fastCount, 1)
+			c.Respond("fast")
+		}
+	}))
+
+	grp := system.Root.Spawn(NewAdaptiveGroup(slow, fast))
+
+	for i := 0; i < 20; i++ {
+		res, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+		assert.NoError(t, err)
+		assert.Contains(t, []interface{}{"slow", "fast"}, res)
+	}
+
+	// once both routees have been measured the slow one is avoided
+	assert.LessOrEqual(t, atomic.LoadInt32(&slowCount), int32(2))
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&fastCount), int32(18))
+}
+
+func TestAdaptiveState_KeepsStatisticsAcrossRouteeChanges(t *testing.T) {
+	a := system.NewLocalPID("a")
+	b := system.NewLocalPID("b")
+
+	state := &adaptiveState{}
+	state.SetRoutees(actor.NewPIDSet(a))
+	state.stats[0].observe(time.Second)
+
+	state.SetRoutees(actor.NewPIDSet(a, b))
+
+	assert.Len(t, state.stats, 2)
+	assert.Equal(t, int64(time.Second), state.stats[0].latency)
+	assert.Equal(t, int64(0), state.stats[1].latency)
+}
+
+func TestAdaptiveGroup_ForwardsResponsesAfterTheResponseTimeout(t *testing.T) {
+	defer func(timeout time.Duration) { adaptiveResponseTimeout = timeout }(adaptiveResponseTimeout)
+	adaptiveResponseTimeout = 20 * time.Millisecond
+
+	slow := system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			time.Sleep(100 * time.Millisecond)
+			c.Respond("slow")
+		}
+	}))
+	grp := system.Root.Spawn(NewAdaptiveGroup(slow))
+
+	res, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "slow", res)
+}
+
+func TestAdaptiveGroup_ReleasesObserverOfGoneSender(t *testing.T) {
+	defer func(timeout time.Duration) { adaptiveResponseTimeout = timeout }(adaptiveResponseTimeout)
+	adaptiveResponseTimeout = 20 * time.Millisecond
+
+	senders := make(chan *actor.PID, 1)
+	silent := system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			senders <- c.Sender()
+		}
+	}))
+
+	state := &adaptiveState{}
+	state.SetSender(system.Root)
+	state.SetRoutees(actor.NewPIDSet(silent))
+
+	_, err := system.Root.RequestFuture(system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			state.RouteMessage(&actor.MessageEnvelope{Message: "ping", Sender: c.Sender()})
+		}
+	})), "ping", 10*time.Millisecond).Result()
+	assert.ErrorIs(t, err, actor.ErrTimeout)
+
+	observer := <-senders
+	assert.Eventually(t, func() bool {
+		_, ok := system.ProcessRegistry.Get(observer)
+		return !ok && atomic.LoadInt64(&state.stats[0].inFlight) == 0
+	}, time.Second, 10*time.Millisecond)
+}
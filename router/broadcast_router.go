@@ -1,6 +1,8 @@
 package router
 
 import (
+	"sync/atomic"
+
 	"github.com/asynkron/protoactor-go/actor"
 )
 
@@ -13,7 +15,7 @@ type broadcastPoolRouter struct {
 }
 
 type broadcastRouterState struct {
-	routees *actor.PIDSet
+	routees atomic.Pointer[actor.PIDSet]
 	sender  actor.SenderContext
 }
 
@@ -22,15 +24,15 @@ func (state *broadcastRouterState) SetSender(sender actor.SenderContext) {
 }
 
 func (state *broadcastRouterState) SetRoutees(routees *actor.PIDSet) {
-	state.routees = routees
+	state.routees.Store(routees)
 }
 
 func (state *broadcastRouterState) GetRoutees() *actor.PIDSet {
-	return state.routees
+	return state.routees.Load()
 }
 
 func (state *broadcastRouterState) RouteMessage(message interface{}) {
-	state.routees.ForEach(func(i int, pid *actor.PID) {
+	state.routees.Load().ForEach(func(i int, pid *actor.PID) {
 		state.sender.Send(pid, message)
 	})
 }
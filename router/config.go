@@ -61,6 +61,7 @@ func spawner(config RouterConfig) actor.SpawnFunc {
 func spawn(actorSystem *actor.ActorSystem, id string, config RouterConfig, props *actor.Props, parentContext actor.SpawnerContext) (*actor.PID, error) {
 	ref := &process{
 		actorSystem: actorSystem,
+		barrier:     newRoutingBarrier(),
 	}
 	proxy, absent := actorSystem.ProcessRegistry.Add(ref, id)
 	if !absent {
@@ -88,10 +89,11 @@ func spawn(actorSystem *actor.ActorSystem, id string, config RouterConfig, props
 		wg.Add(1)
 		ref.router, _ = actor.DefaultSpawner(actorSystem, id+"/router", actor.PropsFromProducer(func() actor.Actor {
 			return &poolRouterActor{
-				props:  &pc,
-				config: config,
-				state:  ref.state,
-				wg:     wg,
+				props:   &pc,
+				config:  config,
+				state:   ref.state,
+				wg:      wg,
+				barrier: ref.barrier,
 			}
 		}), parentContext)
 		wg.Wait() // wait for routerActor to start
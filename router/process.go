@@ -17,6 +17,7 @@ type process struct {
 	watchers    actor.PIDSet
 	stopping    int32
 	actorSystem *actor.ActorSystem
+	barrier     *routingBarrier
 }
 
 var _ actor.Process = &process{}
@@ -30,7 +31,9 @@ func (ref *process) SendUserMessage(pid *actor.PID, message interface{}) {
 		return
 	}
 	if _, ok := msg.(ManagementMessage); !ok {
+		routing := ref.barrier.enter()
 		ref.state.RouteMessage(message)
+		ref.barrier.leave(routing)
 	} else {
 		r, _ := ref.actorSystem.ProcessRegistry.Get(ref.router)
 		// Always send the original message to the router actor,
@@ -101,3 +104,48 @@ func (ref *process) Poison(pid *actor.PID) {
 	ref.actorSystem.ProcessRegistry.Remove(pid)
 	ref.SendSystemMessage(pid, &actor.Stop{})
 }
+
+// routingBarrier tracks the messages being routed by the process, it lets the router actor wait until no message
+// is routed anymore using routees read before the last SetRoutees
+type routingBarrier struct {
+	mu      sync.RWMutex
+	current *sync.WaitGroup
+}
+
+func newRoutingBarrier() *routingBarrier {
+	return &routingBarrier{current: &sync.WaitGroup{}}
+}
+
+func (b *routingBarrier) enter() *sync.WaitGroup {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	b.current.Add(1)
+
+	return b.current
+}
+
+func (b *routingBarrier) leave(routing *sync.WaitGroup) {
+	if routing != nil {
+		routing.Done()
+	}
+}
+
+// wait blocks until all the messages being routed when it was called have been sent, messages routed in the
+// meantime are not waited for
+func (b *routingBarrier) wait() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	previous := b.current
+	b.current = &sync.WaitGroup{}
+	b.mu.Unlock()
+
+	previous.Wait()
+}
@@ -0,0 +1,171 @@
+package router
+
+import (
+	"math"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// Resizer decides how the number of routees of a pool follows its load
+type Resizer interface {
+	// SamplingInterval is how often the routees of the pool are sampled
+	SamplingInterval() time.Duration
+	// Capacity returns the number of routees to add, or remove when negative, given the number of
+	// user messages waiting in the mailbox of each routee
+	Capacity(mailboxSizes []int) int
+}
+
+// PoolResized is published on the EventStream when a resizer changed the number of routees of a pool
+type PoolResized struct {
+	Pool     *actor.PID
+	Previous int
+	Current  int
+}
+
+// ResizerOption configures the resizer created by NewDefaultResizer
+type ResizerOption func(resizer *DefaultResizer)
+
+// WithPressureThreshold sets the number of waiting messages from which a routee is considered busy, defaults to 1
+func WithPressureThreshold(threshold int) ResizerOption {
+	return func(resizer *DefaultResizer) {
+		resizer.pressureThreshold = threshold
+	}
+}
+
+// WithRampupRate sets the fraction of routees added when all routees are busy, defaults to 0.2
+func WithRampupRate(rate float64) ResizerOption {
+	return func(resizer *DefaultResizer) {
+		resizer.rampupRate = rate
+	}
+}
+
+// WithBackoff removes the given fraction of routees, rounded up, when less than threshold of them are busy,
+// defaults to a rate of 0.1 below a threshold of 0.3. A zero rate disables shrinking the pool
+func WithBackoff(threshold float64, rate float64) ResizerOption {
+	return func(resizer *DefaultResizer) {
+		resizer.backoffThreshold = threshold
+		resizer.backoffRate = rate
+	}
+}
+
+// WithSamplingInterval sets how often the routees are sampled, defaults to one second
+func WithSamplingInterval(interval time.Duration) ResizerOption {
+	return func(resizer *DefaultResizer) {
+		resizer.samplingInterval = interval
+	}
+}
+
+// DefaultResizer grows a pool when all its routees are busy and shrinks it when most of them are idle,
+// keeping the number of routees between a lower and an upper bound
+type DefaultResizer struct {
+	lowerBound        int
+	upperBound        int
+	pressureThreshold int
+	rampupRate        float64
+	backoffThreshold  float64
+	backoffRate       float64
+	samplingInterval  time.Duration
+}
+
+var _ Resizer = &DefaultResizer{}
+
+// NewDefaultResizer creates a resizer keeping between lowerBound and upperBound routees
+func NewDefaultResizer(lowerBound int, upperBound int, opts ...ResizerOption) *DefaultResizer {
+	resizer := &DefaultResizer{
+		lowerBound:        lowerBound,
+		upperBound:        upperBound,
+		pressureThreshold: 1,
+		rampupRate:        0.2,
+		backoffThreshold:  0.3,
+		backoffRate:       0.1,
+		samplingInterval:  time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(resizer)
+	}
+
+	return resizer
+}
+
+func (r *DefaultResizer) SamplingInterval() time.Duration {
+	return r.samplingInterval
+}
+
+func (r *DefaultResizer) Capacity(mailboxSizes []int) int {
+	current := len(mailboxSizes)
+	pressure := 0
+
+	for _, size := range mailboxSizes {
+		if size >= r.pressureThreshold {
+			pressure++
+		}
+	}
+
+	proposed := current
+	switch {
+	case current > 0 && pressure == current:
+		proposed += int(math.Ceil(r.rampupRate * float64(current)))
+	case current > 0 && r.backoffRate > 0 && float64(pressure)/float64(current) < r.backoffThreshold:
+		proposed += int(math.Floor(-r.backoffRate * float64(current)))
+	}
+
+	if proposed < r.lowerBound {
+		proposed = r.lowerBound
+	}
+
+	if proposed > r.upperBound {
+		proposed = r.upperBound
+	}
+
+	return proposed - current
+}
+
+// WithResizer makes a pool created by one of the pool constructors, e.g. NewRoundRobinPool, adjust its number of
+// routees using the resizer
+func WithResizer(resizer Resizer) actor.PropsOption {
+	return func(props *actor.Props) {
+		spawn := props.Spawner()
+
+		props.Configure(actor.WithSpawnFunc(func(actorSystem *actor.ActorSystem, id string, props *actor.Props, parentContext actor.SpawnerContext) (*actor.PID, error) {
+			pid, err := spawn(actorSystem, id, props, parentContext)
+			if err != nil {
+				return pid, err
+			}
+
+			if proxy, ok := actorSystem.ProcessRegistry.Get(pid); ok {
+				if ref, ok := proxy.(*process); ok {
+					actorSystem.Root.Send(ref.router, &startResizer{resizer: resizer, pool: pid})
+				}
+			}
+
+			return pid, nil
+		}))
+	}
+}
+
+type startResizer struct {
+	resizer Resizer
+	pool    *actor.PID
+}
+
+type resizeTick struct{}
+
+var resizeTickMessage = &resizeTick{}
+
+// sampleMailboxes returns the number of user messages waiting in the mailbox of every local routee
+func sampleMailboxes(actorSystem *actor.ActorSystem, routees *actor.PIDSet) []int {
+	sizes := make([]int, 0, routees.Len())
+	routees.ForEach(func(_ int, pid *actor.PID) {
+		size := 0
+		if proc, ok := actorSystem.ProcessRegistry.Get(pid); ok {
+			if ref, ok := proc.(*actor.ActorProcess); ok {
+				size = ref.UserMessageCount()
+			}
+		}
+		sizes = append(sizes, size)
+	})
+
+	return sizes
+}
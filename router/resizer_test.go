@@ -0,0 +1,108 @@
+package router
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultResizer_Capacity(t *testing.T) {
+	resizer := NewDefaultResizer(2, 10)
+
+	tests := []struct {
+		name      string
+		mailboxes []int
+		expected  int
+	}{
+		{"grows to lower bound", []int{}, 2},
+		{"grows when all routees are busy", []int{3, 1, 2, 5, 1}, 1},
+		{"keeps size when some routees are busy", []int{1, 0, 1}, 0},
+		{"shrinks when most routees are idle", []int{0, 0, 0, 0, 1}, -1},
+		{"does not shrink below lower bound", []int{0, 0}, 0},
+		{"does not grow above upper bound", []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resizer.Capacity(tt.mailboxes))
+		})
+	}
+}
+
+func TestDefaultResizer_PressureThreshold(t *testing.T) {
+	resizer := NewDefaultResizer(1, 10, WithPressureThreshold(5), WithRampupRate(1))
+
+	assert.Equal(t, 0, resizer.Capacity([]int{5, 4}))
+	assert.Equal(t, 2, resizer.Capacity([]int{5, 6}))
+}
+
+func TestPoolRouterActor_AdjustPoolSize(t *testing.T) {
+	pool := system.Root.Spawn(NewRoundRobinPool(2, actor.WithFunc(func(c actor.Context) {})))
+	defer system.Root.Stop(pool)
+
+	system.Root.Send(pool, &AdjustPoolSize{Change: 3})
+	assert.Len(t, routees(t, pool), 5)
+
+	system.Root.Send(pool, &AdjustPoolSize{Change: -4})
+	assert.Len(t, routees(t, pool), 1)
+}
+
+func TestWithResizer_GrowsBusyPool(t *testing.T) {
+	block := make(chan struct{})
+
+	resizer := NewDefaultResizer(1, 3, WithSamplingInterval(10*time.Millisecond), WithRampupRate(1))
+	pool := system.Root.Spawn(NewRoundRobinPool(1, actor.WithFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			<-block
+		}
+	}), WithResizer(resizer)))
+	defer system.Root.Stop(pool)
+	defer close(block)
+
+	var (
+		mu      sync.Mutex
+		resized []*PoolResized
+	)
+	sub := system.EventStream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*PoolResized); ok && e.Pool.Equal(pool) {
+			mu.Lock()
+			resized = append(resized, e)
+			mu.Unlock()
+		}
+	})
+	defer system.EventStream.Unsubscribe(sub)
+
+	for i := 0; i < 10; i++ {
+		system.Root.Send(pool, "work")
+	}
+
+	assert.Eventually(t, func() bool {
+		return len(routees(t, pool)) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// the new routee is idle until it receives work as well
+	for i := 0; i < 10; i++ {
+		system.Root.Send(pool, "work")
+	}
+
+	assert.Eventually(t, func() bool {
+		return len(routees(t, pool)) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if assert.NotEmpty(t, resized) {
+		assert.Equal(t, 1, resized[0].Previous)
+		assert.Equal(t, 2, resized[0].Current)
+	}
+}
+
+func routees(t *testing.T, pool *actor.PID) []*actor.PID {
+	res, err := system.Root.RequestFuture(pool, &GetRoutees{}, time.Second).Result()
+	assert.NoError(t, err)
+
+	return res.(*Routees).PIDs
+}
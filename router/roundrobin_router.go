@@ -16,7 +16,7 @@ type roundRobinPoolRouter struct {
 
 type roundRobinState struct {
 	index   int32
-	routees *actor.PIDSet
+	routees atomic.Pointer[actor.PIDSet]
 	sender  actor.SenderContext
 }
 
@@ -25,15 +25,15 @@ func (state *roundRobinState) SetSender(sender actor.SenderContext) {
 }
 
 func (state *roundRobinState) SetRoutees(routees *actor.PIDSet) {
-	state.routees = routees
+	state.routees.Store(routees)
 }
 
 func (state *roundRobinState) GetRoutees() *actor.PIDSet {
-	return state.routees
+	return state.routees.Load()
 }
 
 func (state *roundRobinState) RouteMessage(message interface{}) {
-	pid := roundRobinRoutee(&state.index, state.routees)
+	pid := roundRobinRoutee(&state.index, state.routees.Load())
 	state.sender.Send(pid, message)
 }
 
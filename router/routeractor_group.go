@@ -20,7 +20,7 @@ func (a *groupRouterActor) Receive(context actor.Context) {
 		a.wg.Done()
 
 	case *AddRoutee:
-		r := a.state.GetRoutees()
+		r := a.state.GetRoutees().Clone()
 		if r.Contains(m.PID) {
 			return
 		}
@@ -29,7 +29,7 @@ func (a *groupRouterActor) Receive(context actor.Context) {
 		a.state.SetRoutees(r)
 
 	case *RemoveRoutee:
-		r := a.state.GetRoutees()
+		r := a.state.GetRoutees().Clone()
 		if !r.Contains(m.PID) {
 			return
 		}
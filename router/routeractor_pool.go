@@ -8,10 +8,19 @@ import (
 )
 
 type poolRouterActor struct {
-	props  *actor.Props
-	config RouterConfig
-	state  State
-	wg     *sync.WaitGroup
+	props   *actor.Props
+	config  RouterConfig
+	state   State
+	wg      *sync.WaitGroup
+	resizer Resizer
+	pool    *actor.PID
+	timer   *time.Timer
+	barrier *routingBarrier
+}
+
+// routeesDrained is sent to the router actor once no message is routed to the removed routees anymore
+type routeesDrained struct {
+	pids []*actor.PID
 }
 
 func (a *poolRouterActor) Receive(context actor.Context) {
@@ -21,7 +30,7 @@ func (a *poolRouterActor) Receive(context actor.Context) {
 		a.wg.Done()
 
 	case *AddRoutee:
-		r := a.state.GetRoutees()
+		r := a.state.GetRoutees().Clone()
 		if r.Contains(m.PID) {
 			return
 		}
@@ -30,24 +39,34 @@ func (a *poolRouterActor) Receive(context actor.Context) {
 		a.state.SetRoutees(r)
 
 	case *RemoveRoutee:
-		r := a.state.GetRoutees()
-		if !r.Contains(m.PID) {
+		if !a.state.GetRoutees().Contains(m.PID) {
 			return
 		}
 
-		context.Unwatch(m.PID)
-		r.Remove(m.PID)
-		a.state.SetRoutees(r)
-		// sleep for 1ms before sending the poison pill
-		// This is to give some time to the routee actor receive all
-		// the messages. Specially due to the synchronization conditions in
-		// consistent hash router, where a copy of hmc can be obtained before
-		// the update and cause messages routed to a dead routee if there is no
-		// delay. This is a best effort approach and 1ms seems to be acceptable
-		// in terms of both delay it cause to the router actor and the time it
-		// provides for the routee to receive messages before it dies.
-		time.Sleep(time.Millisecond * 1)
-		context.Send(m.PID, &actor.PoisonPill{})
+		a.removeRoutees(context, m.PID)
+
+	case *routeesDrained:
+		// the poison pill is queued after every message routed to the routees, they are processed before it stops
+		for _, pid := range m.pids {
+			context.Send(pid, &actor.PoisonPill{})
+		}
+
+	case *AdjustPoolSize:
+		a.adjustPoolSize(context, int(m.Change))
+
+	case *startResizer:
+		a.resizer = m.resizer
+		a.pool = m.pool
+		a.scheduleResize(context)
+
+	case *resizeTick:
+		a.resize(context)
+		a.scheduleResize(context)
+
+	case *actor.Stopping:
+		if a.timer != nil {
+			a.timer.Stop()
+		}
 
 	case *BroadcastMessage:
 		msg := m.Message
@@ -65,9 +84,83 @@ func (a *poolRouterActor) Receive(context actor.Context) {
 
 		context.Respond(&Routees{PIDs: routees})
 	case *actor.Terminated:
-		r := a.state.GetRoutees()
+		r := a.state.GetRoutees().Clone()
 		if r.Remove(m.Who) {
 			a.state.SetRoutees(r)
 		}
 	}
 }
+
+// removeRoutees swaps the routees of the state, then stops the removed routees once the messages being routed
+// with the previous routees have been delivered
+func (a *poolRouterActor) removeRoutees(context actor.Context, pids ...*actor.PID) {
+	r := a.state.GetRoutees().Clone()
+	for _, pid := range pids {
+		context.Unwatch(pid)
+		r.Remove(pid)
+	}
+	a.state.SetRoutees(r)
+
+	self := context.Self()
+	actorSystem := context.ActorSystem()
+	barrier := a.barrier
+
+	go func() {
+		barrier.wait()
+		actorSystem.Root.Send(self, &routeesDrained{pids: pids})
+	}()
+}
+
+// adjustPoolSize spawns new routees, or stops the most recently added ones when change is negative
+func (a *poolRouterActor) adjustPoolSize(context actor.Context, change int) {
+	r := a.state.GetRoutees().Clone()
+
+	if change > 0 {
+		for i := 0; i < change; i++ {
+			r.Add(context.Spawn(a.props))
+		}
+		a.state.SetRoutees(r)
+
+		return
+	}
+
+	count := -change
+	if count > r.Len() {
+		count = r.Len()
+	}
+
+	if count == 0 {
+		return
+	}
+
+	values := r.Values()
+	removed := make([]*actor.PID, count)
+	copy(removed, values[len(values)-count:])
+	a.removeRoutees(context, removed...)
+}
+
+func (a *poolRouterActor) scheduleResize(context actor.Context) {
+	self := context.Self()
+	actorSystem := context.ActorSystem()
+
+	a.timer = time.AfterFunc(a.resizer.SamplingInterval(), func() {
+		actorSystem.Root.Send(self, resizeTickMessage)
+	})
+}
+
+func (a *poolRouterActor) resize(context actor.Context) {
+	sizes := sampleMailboxes(context.ActorSystem(), a.state.GetRoutees())
+
+	change := a.resizer.Capacity(sizes)
+	if change == 0 {
+		return
+	}
+
+	a.adjustPoolSize(context, change)
+
+	context.ActorSystem().EventStream.Publish(&PoolResized{
+		Pool:     a.pool,
+		Previous: len(sizes),
+		Current:  a.state.GetRoutees().Len(),
+	})
+}
@@ -1,9 +1,12 @@
 package router
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -42,24 +45,73 @@ func TestPoolRouterActor_Receive_AddRoute_NoDuplicates(t *testing.T) {
 func TestPoolRouterActor_Receive_RemoveRoute(t *testing.T) {
 	state := new(testRouterState)
 
-	a := poolRouterActor{state: state}
+	a := poolRouterActor{state: state, barrier: newRoutingBarrier()}
 
 	p1, pr1 := spawnMockProcess("p1")
 	defer removeMockProcess(p1)
-	pr1.On("SendUserMessage", p1, &actor.PoisonPill{}).Once()
+
+	self, selfProcess := spawnMockProcess("self")
+	defer removeMockProcess(self)
+	drained := make(chan interface{}, 1)
+	selfProcess.On("SendUserMessage", self, mock.Anything).Run(func(args mock.Arguments) {
+		drained <- args.Get(1)
+	}).Once()
 
 	p2 := system.NewLocalPID("p2")
 	c := new(mockContext)
-	c.On("Message").Return(&RemoveRoutee{PID: p1})
+	c.On("Message").Return(&RemoveRoutee{PID: p1}).Once()
 	c.On("Unwatch", p1).Once()
-
-	c.On("Send")
+	c.On("Self").Return(self)
+	c.On("ActorSystem").Return(system)
 
 	state.On("GetRoutees").Return(actor.NewPIDSet(p1, p2))
 	state.On("SetRoutees", actor.NewPIDSet(p2)).Once()
 
 	a.Receive(c)
-	mock.AssertExpectationsForObjects(t, state, c)
+	mock.AssertExpectationsForObjects(t, state)
+
+	// the routee is only stopped once the router confirmed no message is routed to it anymore
+	var msg interface{}
+	select {
+	case msg = <-drained:
+	case <-time.After(time.Second):
+		assert.FailNow(t, "routees were not drained")
+	}
+
+	pr1.On("SendUserMessage", p1, &actor.PoisonPill{}).Once()
+	c.On("Message").Return(msg).Once()
+	c.On("Send")
+
+	a.Receive(c)
+	mock.AssertExpectationsForObjects(t, c, pr1)
+}
+
+func TestPoolRouterActor_RemoveRoutee_DrainsRoutedMessages(t *testing.T) {
+	var received int32
+	pool := system.Root.Spawn(NewRoundRobinPool(2, actor.WithFunc(func(c actor.Context) {
+		if _, ok := c.Message().(int); ok {
+			atomic.AddInt32(&received, 1)
+		}
+	})))
+	defer system.Root.Stop(pool)
+
+	pids := routees(t, pool)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			system.Root.Send(pool, i)
+		}
+	}()
+
+	system.Root.Send(pool, &RemoveRoutee{PID: pids[0]})
+	<-done
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&received) == 1000
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, routees(t, pool), 1)
 }
 
 func TestPoolRouterActor_Receive_BroadcastMessage(t *testing.T) {
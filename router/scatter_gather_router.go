@@ -0,0 +1,243 @@
+package router
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+type scatterGatherGroupRouter struct {
+	GroupRouter
+	within time.Duration
+}
+
+type scatterGatherPoolRouter struct {
+	PoolRouter
+	within time.Duration
+}
+
+type scatterGatherState struct {
+	routees atomic.Pointer[actor.PIDSet]
+	sender  actor.SenderContext
+	within  time.Duration
+}
+
+func (state *scatterGatherState) SetSender(sender actor.SenderContext) {
+	state.sender = sender
+}
+
+func (state *scatterGatherState) SetRoutees(routees *actor.PIDSet) {
+	state.routees.Store(routees)
+}
+
+func (state *scatterGatherState) GetRoutees() *actor.PIDSet {
+	return state.routees.Load()
+}
+
+func (state *scatterGatherState) RouteMessage(message interface{}) {
+	routees := state.routees.Load().Values()
+
+	envelope, ok := message.(*actor.MessageEnvelope)
+	if !ok || envelope.Sender == nil {
+		// nobody is waiting for a response, the message is broadcast
+		for _, pid := range routees {
+			state.sender.Send(pid, message)
+		}
+
+		return
+	}
+
+	collector := newFirstResponse(state.sender.ActorSystem(), envelope.Sender, state.within, len(routees), nil)
+	for _, pid := range routees {
+		collector.send(state.sender, pid, envelope)
+	}
+}
+
+// NewScatterGatherFirstCompletedPool creates a pool router sending every request to all routees, the first
+// response is forwarded to the original sender and the others are discarded.
+// Without a response within the given duration the sender gets a ResponseTimeoutError, the routees are not
+// interrupted and their late responses are dropped.
+func NewScatterGatherFirstCompletedPool(size int, within time.Duration, opts ...actor.PropsOption) *actor.Props {
+	return (&actor.Props{}).
+		Configure(actor.WithSpawnFunc(spawner(&scatterGatherPoolRouter{PoolRouter{PoolSize: size}, within}))).
+		Configure(opts...)
+}
+
+// NewScatterGatherFirstCompletedGroup creates a group router sending every request to all routees, the first
+// response is forwarded to the original sender and the others are discarded.
+// Without a response within the given duration the sender gets a ResponseTimeoutError, the routees are not
+// interrupted and their late responses are dropped.
+func NewScatterGatherFirstCompletedGroup(within time.Duration, routees ...*actor.PID) *actor.Props {
+	return (&actor.Props{}).Configure(actor.WithSpawnFunc(spawner(&scatterGatherGroupRouter{GroupRouter{Routees: actor.NewPIDSet(routees...)}, within})))
+}
+
+func (config *scatterGatherPoolRouter) CreateRouterState() State {
+	return &scatterGatherState{within: config.within}
+}
+
+func (config *scatterGatherGroupRouter) CreateRouterState() State {
+	return &scatterGatherState{within: config.within}
+}
+
+// firstResponse is registered as the sender of the requests a router fans out to its routees, it forwards the
+// first response to the original sender and drops the rest. Once the deadline passes without a response, the
+// sender gets a ResponseTimeoutError.
+// The routees cannot be interrupted, the collector stays registered until every routee asked has answered or the
+// deadline passed, so that the responses after the first one are dropped rather than sent to the dead letters.
+// The dead letter responses of dead or removed routees are only forwarded once none of the routees can answer anymore.
+type firstResponse struct {
+	actorSystem  *actor.ActorSystem
+	pid          *actor.PID
+	target       *actor.PID
+	within       time.Duration
+	mu           sync.Mutex
+	timers       []*time.Timer
+	deadline     *time.Timer
+	done         int32
+	removed      int32
+	pending      int32
+	outstanding  int32
+	onDeadLetter func()
+}
+
+var _ actor.Process = &firstResponse{}
+
+// newFirstResponse creates a collector for the responses of the given number of routees, onDeadLetter is called
+// when a routee answered with a dead letter while others still can answer
+func newFirstResponse(actorSystem *actor.ActorSystem, target *actor.PID, within time.Duration, routees int, onDeadLetter func()) *firstResponse {
+	ref := &firstResponse{
+		actorSystem:  actorSystem,
+		target:       target,
+		within:       within,
+		pending:      int32(routees),
+		onDeadLetter: onDeadLetter,
+	}
+
+	ref.pid, _ = actorSystem.ProcessRegistry.Add(ref, "first"+actorSystem.ProcessRegistry.NextId())
+
+	ref.mu.Lock()
+	ref.deadline = time.AfterFunc(within, ref.expire)
+	ref.mu.Unlock()
+
+	return ref
+}
+
+// send forwards the request to the routee with the collector as sender, keeping the original headers
+func (ref *firstResponse) send(sender actor.SenderContext, pid *actor.PID, envelope *actor.MessageEnvelope) {
+	if ref.completed() {
+		return
+	}
+
+	atomic.AddInt32(&ref.outstanding, 1)
+
+	request := *envelope
+	request.Sender = ref.pid
+	sender.Send(pid, &request)
+}
+
+// schedule runs f after the delay unless the collector completes first
+func (ref *firstResponse) schedule(delay time.Duration, f func()) {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	if ref.completed() {
+		return
+	}
+
+	ref.timers = append(ref.timers, time.AfterFunc(delay, f))
+}
+
+func (ref *firstResponse) completed() bool {
+	return atomic.LoadInt32(&ref.done) == 1
+}
+
+// complete marks the sender as answered and stops asking the routees
+func (ref *firstResponse) complete() bool {
+	if !atomic.CompareAndSwapInt32(&ref.done, 0, 1) {
+		return false
+	}
+
+	ref.mu.Lock()
+	for _, timer := range ref.timers {
+		timer.Stop()
+	}
+	ref.timers = nil
+	ref.mu.Unlock()
+
+	return true
+}
+
+// expire answers the sender with a timeout unless it got a response, the late responses are not awaited anymore
+func (ref *firstResponse) expire() {
+	if ref.complete() {
+		ref.forward(&actor.ResponseTimeoutError{Timeout: ref.within})
+	}
+
+	ref.remove()
+}
+
+func (ref *firstResponse) remove() {
+	if !atomic.CompareAndSwapInt32(&ref.removed, 0, 1) {
+		return
+	}
+
+	ref.mu.Lock()
+	ref.deadline.Stop()
+	ref.mu.Unlock()
+
+	ref.actorSystem.ProcessRegistry.Remove(ref.pid)
+}
+
+// removeAnswered removes the collector once the sender got a response and every routee asked answered
+func (ref *firstResponse) removeAnswered() {
+	if ref.completed() && atomic.LoadInt32(&ref.outstanding) <= 0 {
+		ref.remove()
+	}
+}
+
+func (ref *firstResponse) forward(message interface{}) {
+	target, _ := ref.actorSystem.ProcessRegistry.Get(ref.target)
+	target.SendUserMessage(ref.target, message)
+}
+
+// skip handles a routee that cannot answer like a dead letter response of the routee
+func (ref *firstResponse) skip(pid *actor.PID) {
+	ref.respond(&actor.DeadLetterResponse{Target: pid})
+	ref.removeAnswered()
+}
+
+func (ref *firstResponse) respond(message interface{}) {
+	if _, ok := message.(*actor.DeadLetterResponse); ok && atomic.AddInt32(&ref.pending, -1) > 0 {
+		if ref.onDeadLetter != nil && !ref.completed() {
+			ref.onDeadLetter()
+		}
+
+		return
+	}
+
+	if ref.complete() {
+		ref.forward(message)
+	}
+}
+
+func (ref *firstResponse) SendUserMessage(_ *actor.PID, message interface{}) {
+	atomic.AddInt32(&ref.outstanding, -1)
+	ref.respond(message)
+	ref.removeAnswered()
+}
+
+func (ref *firstResponse) SendSystemMessage(_ *actor.PID, message interface{}) {
+	if ref.complete() {
+		target, _ := ref.actorSystem.ProcessRegistry.Get(ref.target)
+		target.SendSystemMessage(ref.target, message)
+	}
+
+	ref.remove()
+}
+
+func (ref *firstResponse) Stop(_ *actor.PID) {
+	ref.complete()
+	ref.remove()
+}
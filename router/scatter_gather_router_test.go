@@ -0,0 +1,132 @@
+package router
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func delayedResponder(delay time.Duration, response string, received *int32) *actor.PID {
+	return system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			atomic.AddInt32(received, 1)
+			time.Sleep(delay)
+			c.Respond(response)
+		}
+	}))
+}
+
+// remoteResponder answers like delayedResponder to a copy of the sender, as a routee on another node receives it
+func remoteResponder(delay time.Duration, response string) *actor.PID {
+	return system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			time.Sleep(delay)
+			c.Send(actor.NewPID(c.Sender().Address, c.Sender().Id), response)
+		}
+	}))
+}
+
+// stoppedRoutee returns the pid of a routee which is already stopped, requests to it are answered with a dead letter
+func stoppedRoutee() *actor.PID {
+	pid := system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {}))
+	_ = system.Root.StopFuture(pid).Wait()
+
+	return pid
+}
+
+func TestScatterGatherFirstCompletedGroup_RespondsWithFirstResponse(t *testing.T) {
+	var slowCount, fastCount int32
+	slow := delayedResponder(100*time.Millisecond, "slow", &slowCount)
+	fast := delayedResponder(0, "fast", &fastCount)
+
+	grp := system.Root.Spawn(NewScatterGatherFirstCompletedGroup(time.Second, slow, fast))
+
+	res, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "fast", res)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fastCount))
+	// the slow routee received the request as well
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&slowCount) == 1
+	}, time.Second, time.Millisecond)
+}
+
+func TestScatterGatherFirstCompletedGroup_PreservesSender(t *testing.T) {
+	var count int32
+	routee := delayedResponder(0, "pong", &count)
+	grp := system.Root.Spawn(NewScatterGatherFirstCompletedGroup(time.Second, routee))
+
+	future := actor.NewFuture(system, time.Second)
+	system.Root.RequestWithCustomSender(grp, "ping", future.PID())
+
+	res, err := future.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "pong", res)
+}
+
+func TestScatterGatherFirstCompletedGroup_DropsLateResponses(t *testing.T) {
+	var count int32
+	slow := delayedResponder(100*time.Millisecond, "slow", &count)
+
+	grp := system.Root.Spawn(NewScatterGatherFirstCompletedGroup(10*time.Millisecond, slow))
+
+	start := time.Now()
+	_, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.ErrorIs(t, err, actor.ErrTimeout)
+	// the router answered with a timeout before the future timed out by itself
+	var timeout *actor.ResponseTimeoutError
+	assert.ErrorAs(t, err, &timeout)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+// collectorDeadLetters counts the responses sent as dead letters to the response collectors of the routers
+func collectorDeadLetters(response string) (*int32, func()) {
+	var count int32
+	sub := system.EventStream.Subscribe(func(evt interface{}) {
+		if deadLetter, ok := evt.(*actor.DeadLetterEvent); ok && strings.HasPrefix(deadLetter.PID.Id, "first") && deadLetter.Message == response {
+			atomic.AddInt32(&count, 1)
+		}
+	})
+
+	return &count, func() { system.EventStream.Unsubscribe(sub) }
+}
+
+func TestScatterGatherFirstCompletedGroup_LateResponsesAreNotDeadLetters(t *testing.T) {
+	deadLetters, unsubscribe := collectorDeadLetters("late")
+	defer unsubscribe()
+
+	var fastCount int32
+	slow := remoteResponder(50*time.Millisecond, "late")
+	fast := delayedResponder(0, "fast", &fastCount)
+
+	grp := system.Root.Spawn(NewScatterGatherFirstCompletedGroup(time.Second, slow, fast))
+
+	res, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "fast", res)
+
+	time.Sleep(150 * time.Millisecond)
+	assert.Zero(t, atomic.LoadInt32(deadLetters))
+}
+
+func TestScatterGatherFirstCompletedGroup_SkipsDeadRoutees(t *testing.T) {
+	var count int32
+	live := delayedResponder(50*time.Millisecond, "live", &count)
+
+	grp := system.Root.Spawn(NewScatterGatherFirstCompletedGroup(time.Second, stoppedRoutee(), live))
+
+	res, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "live", res)
+}
+
+func TestScatterGatherFirstCompletedGroup_ForwardsDeadLetterOfAllRoutees(t *testing.T) {
+	grp := system.Root.Spawn(NewScatterGatherFirstCompletedGroup(time.Second, stoppedRoutee(), stoppedRoutee()))
+
+	_, err := system.Root.RequestFuture(grp, "ping", 500*time.Millisecond).Result()
+	assert.ErrorIs(t, err, actor.ErrDeadLetter)
+}
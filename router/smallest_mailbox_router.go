@@ -0,0 +1,103 @@
+package router
+
+import (
+	"sync/atomic"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+type smallestMailboxGroupRouter struct {
+	GroupRouter
+}
+
+type smallestMailboxPoolRouter struct {
+	PoolRouter
+}
+
+type smallestMailboxState struct {
+	index   int32
+	routees atomic.Pointer[actor.PIDSet]
+	sender  actor.SenderContext
+}
+
+func (state *smallestMailboxState) SetSender(sender actor.SenderContext) {
+	state.sender = sender
+}
+
+func (state *smallestMailboxState) SetRoutees(routees *actor.PIDSet) {
+	state.routees.Store(routees)
+}
+
+func (state *smallestMailboxState) GetRoutees() *actor.PIDSet {
+	return state.routees.Load()
+}
+
+func (state *smallestMailboxState) RouteMessage(message interface{}) {
+	pid := state.smallestMailboxRoutee()
+	state.sender.Send(pid, message)
+}
+
+// smallestMailboxRoutee picks the local routee with the fewest queued user messages, starting the scan at a
+// rotating offset so that idle routees share the load. Routees whose mailbox size is unknown, e.g. remote
+// actors, are only used when there is no local routee.
+func (state *smallestMailboxState) smallestMailboxRoutee() *actor.PID {
+	routees := state.routees.Load().Values()
+	registry := state.sender.ActorSystem().ProcessRegistry
+	offset := int(uint32(atomic.AddInt32(&state.index, 1)))
+
+	var (
+		target   *actor.PID
+		fallback *actor.PID
+		smallest = -1
+	)
+
+	for i := range routees {
+		pid := routees[(offset+i)%len(routees)]
+
+		proc, ok := registry.Get(pid)
+		ap, local := proc.(*actor.ActorProcess)
+		if !ok || !local {
+			if fallback == nil {
+				fallback = pid
+			}
+
+			continue
+		}
+
+		count := ap.UserMessageCount()
+		if count == 0 {
+			return pid
+		}
+
+		if smallest == -1 || count < smallest {
+			smallest = count
+			target = pid
+		}
+	}
+
+	if target == nil {
+		return fallback
+	}
+
+	return target
+}
+
+// NewSmallestMailboxPool creates a pool router sending each message to the routee with the fewest queued messages
+func NewSmallestMailboxPool(size int, opts ...actor.PropsOption) *actor.Props {
+	return (&actor.Props{}).
+		Configure(actor.WithSpawnFunc(spawner(&smallestMailboxPoolRouter{PoolRouter{PoolSize: size}}))).
+		Configure(opts...)
+}
+
+// NewSmallestMailboxGroup creates a group router sending each message to the routee with the fewest queued messages
+func NewSmallestMailboxGroup(routees ...*actor.PID) *actor.Props {
+	return (&actor.Props{}).Configure(actor.WithSpawnFunc(spawner(&smallestMailboxGroupRouter{GroupRouter{Routees: actor.NewPIDSet(routees...)}})))
+}
+
+func (config *smallestMailboxPoolRouter) CreateRouterState() State {
+	return &smallestMailboxState{}
+}
+
+func (config *smallestMailboxGroupRouter) CreateRouterState() State {
+	return &smallestMailboxState{}
+}
@@ -0,0 +1,88 @@
+package router
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmallestMailboxGroup_PrefersIdleRoutee(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+
+	busy := system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			<-block
+		}
+	}))
+
+	var (
+		received int32
+		wg       sync.WaitGroup
+	)
+	idle := system.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			atomic.AddInt32(&received, 1)
+			wg.Done()
+		}
+	}))
+
+	// keep the busy routee processing while more messages pile up in its mailbox
+	for i := 0; i < 5; i++ {
+		system.Root.Send(busy, "work")
+	}
+
+	grp := system.Root.Spawn(NewSmallestMailboxGroup(busy, idle))
+
+	wg.Add(10)
+	for i := 0; i < 10; i++ {
+		system.Root.Send(grp, "work")
+		time.Sleep(time.Millisecond)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "timed out waiting for the idle routee")
+	}
+	assert.Equal(t, int32(10), atomic.LoadInt32(&received))
+}
+
+func TestSmallestMailboxPool_RoutesToAllRoutees(t *testing.T) {
+	var wg sync.WaitGroup
+	props := NewSmallestMailboxPool(3, actor.WithFunc(func(c actor.Context) {
+		if _, ok := c.Message().(string); ok {
+			wg.Done()
+		}
+	}))
+
+	pool := system.Root.Spawn(props)
+	defer system.Root.Stop(pool)
+
+	wg.Add(30)
+	for i := 0; i < 30; i++ {
+		system.Root.Send(pool, "work")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "timed out waiting for the routees")
+	}
+}
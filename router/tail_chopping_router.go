@@ -0,0 +1,117 @@
+package router
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+type tailChoppingGroupRouter struct {
+	GroupRouter
+	within   time.Duration
+	interval time.Duration
+}
+
+type tailChoppingPoolRouter struct {
+	PoolRouter
+	within   time.Duration
+	interval time.Duration
+}
+
+type tailChoppingState struct {
+	index    int32
+	routees  atomic.Pointer[actor.PIDSet]
+	sender   actor.SenderContext
+	within   time.Duration
+	interval time.Duration
+}
+
+func (state *tailChoppingState) SetSender(sender actor.SenderContext) {
+	state.sender = sender
+}
+
+func (state *tailChoppingState) SetRoutees(routees *actor.PIDSet) {
+	state.routees.Store(routees)
+}
+
+func (state *tailChoppingState) GetRoutees() *actor.PIDSet {
+	return state.routees.Load()
+}
+
+func (state *tailChoppingState) RouteMessage(message interface{}) {
+	routees := state.routees.Load().Values()
+	if len(routees) == 0 {
+		state.sender.Send(nil, message)
+
+		return
+	}
+
+	offset := int(uint32(atomic.AddInt32(&state.index, 1)))
+
+	envelope, ok := message.(*actor.MessageEnvelope)
+	if !ok || envelope.Sender == nil {
+		// nobody is waiting for a response, the message is sent to a single routee
+		state.sender.Send(routees[offset%len(routees)], message)
+
+		return
+	}
+
+	var collector *firstResponse
+
+	// step is the index of the last routee asked, the request moves on to the next routee once, either after the
+	// interval or as soon as the routee answered with a dead letter
+	step := int32(-1)
+
+	var next func(i int)
+	next = func(i int) {
+		if i >= len(routees) || !atomic.CompareAndSwapInt32(&step, int32(i-1), int32(i)) {
+			return
+		}
+
+		pid := routees[(offset+i)%len(routees)]
+		if i > 0 && !state.routees.Load().Contains(pid) {
+			// the routee was removed while waiting for the previous ones, it is skipped like a dead one
+			collector.skip(pid)
+
+			return
+		}
+
+		collector.send(state.sender, pid, envelope)
+
+		if i+1 < len(routees) {
+			collector.schedule(state.interval, func() { next(i + 1) })
+		}
+	}
+
+	collector = newFirstResponse(state.sender.ActorSystem(), envelope.Sender, state.within, len(routees), func() {
+		next(int(atomic.LoadInt32(&step)) + 1)
+	})
+	next(0)
+}
+
+// NewTailChoppingPool creates a pool router sending every request to one routee and, while no response arrived,
+// to the next routee after every interval. The first response is forwarded to the original sender, without a
+// response within the given duration the sender gets a ResponseTimeoutError. The routees are not interrupted and
+// their late responses are dropped.
+func NewTailChoppingPool(size int, within time.Duration, interval time.Duration, opts ...actor.PropsOption) *actor.Props {
+	return (&actor.Props{}).
+		Configure(actor.WithSpawnFunc(spawner(&tailChoppingPoolRouter{PoolRouter{PoolSize: size}, within, interval}))).
+		Configure(opts...)
+}
+
+// NewTailChoppingGroup creates a group router sending every request to one routee and, while no response arrived,
+// to the next routee after every interval. The first response is forwarded to the original sender, without a
+// response within the given duration the sender gets a ResponseTimeoutError. The routees are not interrupted and
+// their late responses are dropped.
+func NewTailChoppingGroup(within time.Duration, interval time.Duration, routees ...*actor.PID) *actor.Props {
+	return (&actor.Props{}).Configure(actor.WithSpawnFunc(spawner(&tailChoppingGroupRouter{GroupRouter{Routees: actor.NewPIDSet(routees...)}, within, interval})))
+}
+
+func (config *tailChoppingPoolRouter) CreateRouterState() State {
+	return &tailChoppingState{within: config.within, interval: config.interval}
+}
+
+func (config *tailChoppingGroupRouter) CreateRouterState() State {
+	return &tailChoppingState{within: config.within, interval: config.interval}
+}
@@ -0,0 +1,94 @@
+package router
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTailChoppingGroup_FallsBackToNextRoutee(t *testing.T) {
+	var silentCount, fastCount int32
+	// the first routee never answers within the deadline, the second one is asked after the interval
+	silent := delayedResponder(time.Second, "silent", &silentCount)
+	fast := delayedResponder(0, "fast", &fastCount)
+
+	grp := system.Root.Spawn(NewTailChoppingGroup(500*time.Millisecond, 20*time.Millisecond, silent, fast))
+
+	for i := 0; i < 2; i++ {
+		res, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, "fast", res)
+	}
+}
+
+func TestTailChoppingGroup_StopsAfterFirstResponse(t *testing.T) {
+	var firstCount, secondCount int32
+	first := delayedResponder(0, "first", &firstCount)
+	second := delayedResponder(0, "second", &secondCount)
+
+	grp := system.Root.Spawn(NewTailChoppingGroup(time.Second, 100*time.Millisecond, first, second))
+
+	_, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.NoError(t, err)
+
+	time.Sleep(150 * time.Millisecond)
+
+	// only one of the routees was asked since it answered before the interval elapsed
+	assert.Equal(t, int32(1), atomic.LoadInt32(&firstCount)+atomic.LoadInt32(&secondCount))
+}
+
+func TestTailChoppingGroup_SkipsDeadRoutees(t *testing.T) {
+	var count int32
+	live := delayedResponder(0, "live", &count)
+
+	// the interval is longer than the request timeout, the live routee is only reached in time when the dead one
+	// is skipped as soon as it answered with a dead letter
+	grp := system.Root.Spawn(NewTailChoppingGroup(2*time.Second, time.Second, stoppedRoutee(), live))
+
+	for i := 0; i < 2; i++ {
+		res, err := system.Root.RequestFuture(grp, "ping", 500*time.Millisecond).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, "live", res)
+	}
+}
+
+func TestTailChoppingGroup_ForwardsDeadLetterOfAllRoutees(t *testing.T) {
+	grp := system.Root.Spawn(NewTailChoppingGroup(2*time.Second, time.Second, stoppedRoutee(), stoppedRoutee()))
+
+	_, err := system.Root.RequestFuture(grp, "ping", 500*time.Millisecond).Result()
+	assert.ErrorIs(t, err, actor.ErrDeadLetter)
+}
+
+func TestTailChoppingGroup_RespondsWithTimeout(t *testing.T) {
+	var count int32
+	silent := delayedResponder(200*time.Millisecond, "silent", &count)
+
+	grp := system.Root.Spawn(NewTailChoppingGroup(20*time.Millisecond, 10*time.Millisecond, silent))
+
+	start := time.Now()
+	_, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.ErrorIs(t, err, actor.ErrTimeout)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestTailChoppingGroup_LateResponsesAreNotDeadLetters(t *testing.T) {
+	deadLetters, unsubscribe := collectorDeadLetters("late")
+	defer unsubscribe()
+
+	var fastCount int32
+	// the slow routee is asked first and answers after the fast one that was asked after the interval
+	slow := remoteResponder(50*time.Millisecond, "late")
+	fast := delayedResponder(0, "fast", &fastCount)
+
+	grp := system.Root.Spawn(NewTailChoppingGroup(time.Second, 10*time.Millisecond, fast, slow))
+
+	res, err := system.Root.RequestFuture(grp, "ping", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "fast", res)
+
+	time.Sleep(150 * time.Millisecond)
+	assert.Zero(t, atomic.LoadInt32(deadLetters))
+}
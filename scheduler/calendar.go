@@ -0,0 +1,60 @@
+package scheduler
+
+import "time"
+
+// Calendar restricts the fire times of a schedule, fire times the calendar does not include are skipped
+type Calendar interface {
+	Includes(t time.Time) bool
+}
+
+// CalendarFunc is a function adapter for Calendar
+type CalendarFunc func(t time.Time) bool
+
+// Includes calls f(t)
+func (f CalendarFunc) Includes(t time.Time) bool {
+	return f(t)
+}
+
+// ExcludeDays creates a calendar excluding whole days, e.g. holidays. A day is compared in the time zone of
+// the fire time
+func ExcludeDays(days ...time.Time) Calendar {
+	excluded := make(map[[3]int]struct{}, len(days))
+	for _, day := range days {
+		excluded[[3]int{day.Year(), int(day.Month()), day.Day()}] = struct{}{}
+	}
+
+	return CalendarFunc(func(t time.Time) bool {
+		_, ok := excluded[[3]int{t.Year(), int(t.Month()), t.Day()}]
+
+		return !ok
+	})
+}
+
+// ExcludeWeekdays creates a calendar excluding the given days of the week
+func ExcludeWeekdays(weekdays ...time.Weekday) Calendar {
+	var excluded [7]bool
+	for _, weekday := range weekdays {
+		excluded[weekday] = true
+	}
+
+	return CalendarFunc(func(t time.Time) bool {
+		return !excluded[t.Weekday()]
+	})
+}
+
+// maxExcludedFireTimes bounds the fire times skipped in a row, a calendar excluding all of them ends the schedule
+const maxExcludedFireTimes = 100_000
+
+// nextIncluded returns the first fire time of the schedule after the given time the calendar includes
+func nextIncluded(schedule Schedule, calendar Calendar, after time.Time) time.Time {
+	for i := 0; i < maxExcludedFireTimes; i++ {
+		t := schedule.Next(after)
+		if t.IsZero() || calendar == nil || calendar.Includes(t) {
+			return t
+		}
+
+		after = t
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,218 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule computes the fire times of a recurring schedule
+type Schedule interface {
+	// Next returns the first fire time strictly after the given time, or the zero time when there is none
+	Next(after time.Time) time.Time
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	secondField = cronField{name: "second", min: 0, max: 59}
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// CronSchedule is a Schedule defined by a cron expression evaluated in a time zone
+type CronSchedule struct {
+	spec     string
+	location *time.Location
+	second   uint64
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	// day of month and day of week restricted both, a day matches when either of them matches
+	anyDay bool
+}
+
+var _ Schedule = &CronSchedule{}
+
+// ParseCron parses a cron expression evaluated in the given time zone, time.Local when nil.
+//
+// The expression has five fields, minute, hour, day of month, month and day of week, or six with a leading
+// seconds field. Fields accept *, ?, lists, ranges and steps, e.g. "*/15 9-17 * * MON-FRI". The descriptors
+// @yearly, @monthly, @weekly, @daily and @hourly are supported as well.
+func ParseCron(spec string, location *time.Location) (*CronSchedule, error) {
+	if location == nil {
+		location = time.Local
+	}
+
+	expr := strings.TrimSpace(spec)
+	if descriptor, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron: expected 5 or 6 fields in %q, got %d", spec, len(fields))
+	}
+
+	schedule := &CronSchedule{spec: spec, location: location}
+	targets := []*uint64{&schedule.second, &schedule.minute, &schedule.hour, &schedule.dom, &schedule.month, &schedule.dow}
+	definitions := []cronField{secondField, minuteField, hourField, domField, monthField, dowField}
+
+	for i, field := range fields {
+		bits, err := definitions[i].parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("cron: %q: %w", spec, err)
+		}
+		*targets[i] = bits
+	}
+
+	// 7 is an alias for sunday
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow = schedule.dow&^(1<<7) | 1
+	}
+
+	schedule.anyDay = !isWildcard(fields[3]) && !isWildcard(fields[5])
+
+	return schedule, nil
+}
+
+func isWildcard(field string) bool {
+	return field == "*" || field == "?"
+}
+
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		lo, hi, step := f.min, f.max, 1
+
+		rng := part
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, part)
+			}
+			step = s
+			rng = part[:i]
+		}
+
+		if !isWildcard(rng) {
+			bounds := strings.SplitN(rng, "-", 2)
+
+			var err error
+			if lo, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+
+			switch {
+			case len(bounds) == 2:
+				if hi, err = f.value(bounds[1]); err != nil {
+					return 0, err
+				}
+			case step == 1:
+				hi = lo
+			}
+
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range in %s field %q", f.name, part)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q", f.name, s)
+	}
+
+	return v, nil
+}
+
+// Location returns the time zone the expression is evaluated in
+func (c *CronSchedule) Location() *time.Location {
+	return c.location
+}
+
+// String returns the cron expression
+func (c *CronSchedule) String() string {
+	return c.spec
+}
+
+// Next returns the first time after the given one matching the expression, or the zero time when there is none
+// within five years
+func (c *CronSchedule) Next(after time.Time) time.Time {
+	t := after.In(c.location).Truncate(time.Second).Add(time.Second)
+	limit := t.Year() + 5
+
+	// the hour, minute and second are advanced with durations rather than time.Date to never move back in time
+	// when the clock is turned back at the end of daylight saving time
+	for t.Year() <= limit {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.location)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.location)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Add(time.Duration(60-t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Duration(60-t.Second()) * time.Second)
+		case c.second&(1<<uint(t.Second())) == 0:
+			t = t.Add(time.Second)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (c *CronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.anyDay {
+		return dom || dow
+	}
+
+	return dom && dow
+}
@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron_Next(t *testing.T) {
+	from := time.Date(2024, time.March, 15, 10, 30, 20, 0, time.UTC) // a friday
+
+	tests := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.March, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * * *", time.Date(2024, time.March, 15, 10, 30, 30, 0, time.UTC)},
+		{"0 9-17 * * MON-FRI", time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC)},
+		{"0 9 * * mon", time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"30 8 1,15 * *", time.Date(2024, time.April, 1, 8, 30, 0, 0, time.UTC)},
+		{"0 12 13 * 5", time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := ParseCron(tt.spec, time.UTC)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(from))
+		})
+	}
+}
+
+func TestParseCron_TimeZone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	schedule, err := ParseCron("0 9 * * *", berlin)
+	require.NoError(t, err)
+
+	next := schedule.Next(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2024, time.July, 1, 7, 0, 0, 0, time.UTC), next.UTC())
+
+	// the clock is set forward from 02:00 to 03:00, the nonexistent hour is skipped
+	schedule, err = ParseCron("30 2 * * *", berlin)
+	require.NoError(t, err)
+
+	next = schedule.Next(time.Date(2024, time.March, 30, 12, 0, 0, 0, berlin))
+	assert.Equal(t, time.Date(2024, time.April, 1, 2, 30, 0, 0, berlin), next)
+
+	// the clock is set back from 03:00 to 02:00, every fire time moves forward
+	schedule, err = ParseCron("*/30 * * * *", berlin)
+	require.NoError(t, err)
+
+	prev := time.Date(2024, time.October, 27, 1, 0, 0, 0, berlin)
+	for i := 0; i < 8; i++ {
+		next := schedule.Next(prev)
+		assert.Equal(t, 30*time.Minute, next.Sub(prev))
+		prev = next
+	}
+}
+
+func TestParseCron_Errors(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+		_, err := ParseCron(spec, time.UTC)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestCalendar_SkipsExcludedFireTimes(t *testing.T) {
+	schedule, err := ParseCron("0 9 * * *", time.UTC)
+	require.NoError(t, err)
+
+	holiday := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)
+	calendar := ExcludeDays(holiday)
+	weekend := ExcludeWeekdays(time.Saturday, time.Sunday)
+
+	from := time.Date(2024, time.March, 14, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, time.March, 16, 9, 0, 0, 0, time.UTC), nextIncluded(schedule, calendar, from))
+	assert.Equal(t, time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC), nextIncluded(schedule, CalendarFunc(func(t time.Time) bool {
+		return calendar.Includes(t) && weekend.Includes(t)
+	}), from))
+}
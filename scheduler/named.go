@@ -0,0 +1,338 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+var (
+	// ErrScheduleExists is returned when scheduling an ID that is already scheduled
+	ErrScheduleExists = errors.New("scheduler: schedule already exists")
+	// ErrUnknownCalendar is returned when a schedule refers to a calendar that was not added to the scheduler
+	ErrUnknownCalendar = errors.New("scheduler: unknown calendar")
+)
+
+// MisfirePolicy decides what happens to the fire times a persisted schedule missed while the process was down
+type MisfirePolicy int32
+
+const (
+	// MisfireFireOnce fires once for all the missed fire times as soon as the schedule is restored
+	MisfireFireOnce MisfirePolicy = iota
+	// MisfireSkip ignores the missed fire times
+	MisfireSkip
+	// MisfireFireAll fires once for every missed fire time as soon as the schedule is restored
+	MisfireFireAll
+)
+
+// ScheduleInfo describes a named schedule
+type ScheduleInfo struct {
+	ID       string
+	Cron     string
+	Target   *actor.PID
+	NextFire time.Time
+	LastFire time.Time
+}
+
+// ScheduleOption configures a named schedule
+type ScheduleOption func(record *ScheduleRecord)
+
+// InLocation evaluates the cron expression in the given time zone rather than time.Local
+func InLocation(location *time.Location) ScheduleOption {
+	return func(record *ScheduleRecord) {
+		record.Location = location.String()
+	}
+}
+
+// WithCalendar skips the fire times not included in the calendar added to the scheduler with the given name
+func WithCalendar(name string) ScheduleOption {
+	return func(record *ScheduleRecord) {
+		record.Calendar = name
+	}
+}
+
+// WithMisfirePolicy sets what happens to the fire times missed while the process was down, defaults to MisfireFireOnce
+func WithMisfirePolicy(policy MisfirePolicy) ScheduleOption {
+	return func(record *ScheduleRecord) {
+		record.Misfire = policy
+	}
+}
+
+// AsRequest uses actor.SenderContext.Request rather than Send to forward the message
+func AsRequest() ScheduleOption {
+	return func(record *ScheduleRecord) {
+		record.Request = true
+	}
+}
+
+type namedSchedule struct {
+	mu       sync.Mutex
+	record   ScheduleRecord
+	schedule Schedule
+	calendar Calendar
+	timer    *time.Timer
+	next     time.Time
+	stopped  bool
+}
+
+// AddCalendar registers a calendar schedules can refer to by name with WithCalendar
+func (s *TimerScheduler) AddCalendar(name string, calendar Calendar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calendars[name] = calendar
+}
+
+// ScheduleCron forwards the message to pid at every fire time of the cron expression, see ParseCron.
+// The schedule is saved to the store of the scheduler, if any, and can be cancelled by its ID.
+func (s *TimerScheduler) ScheduleCron(id string, spec string, pid *actor.PID, message interface{}, opts ...ScheduleOption) error {
+	record := &ScheduleRecord{
+		ID:       id,
+		Cron:     spec,
+		Location: time.Local.String(),
+		Target:   pid,
+		Message:  message,
+		Created:  time.Now(),
+	}
+
+	for _, opt := range opts {
+		opt(record)
+	}
+
+	entry, err := s.newNamedSchedule(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.schedules[id]; ok {
+		return fmt.Errorf("%w: %s", ErrScheduleExists, id)
+	}
+
+	if s.store != nil {
+		if err := s.store.SaveSchedule(record); err != nil {
+			return err
+		}
+	}
+
+	s.schedules[id] = entry
+	s.start(entry, record.Created)
+
+	return nil
+}
+
+// Cancel stops the named schedule and removes it from the store, it returns false if there is no such schedule
+func (s *TimerScheduler) Cancel(id string) (bool, error) {
+	s.mu.Lock()
+	entry, ok := s.schedules[id]
+	delete(s.schedules, id)
+	s.mu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+
+	entry.stop()
+
+	if s.store != nil {
+		return true, s.store.DeleteSchedule(id)
+	}
+
+	return true, nil
+}
+
+// Schedules lists the named schedules ordered by ID
+func (s *TimerScheduler) Schedules() []ScheduleInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := make([]ScheduleInfo, 0, len(s.schedules))
+	for _, entry := range s.schedules {
+		entry.mu.Lock()
+		infos = append(infos, ScheduleInfo{
+			ID:       entry.record.ID,
+			Cron:     entry.record.Cron,
+			Target:   entry.record.Target,
+			NextFire: entry.next,
+			LastFire: entry.record.LastFire,
+		})
+		entry.mu.Unlock()
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+
+	return infos
+}
+
+// Restore starts the schedules saved in the store, applying their misfire policy to the fire times missed
+// since they last fired. Calendars the schedules refer to must be added before.
+func (s *TimerScheduler) Restore() error {
+	if s.store == nil {
+		return nil
+	}
+
+	records, err := s.store.LoadSchedules()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, record := range records {
+		entry, err := s.newNamedSchedule(record)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		s.mu.Lock()
+		if _, ok := s.schedules[record.ID]; ok {
+			s.mu.Unlock()
+			continue
+		}
+		s.schedules[record.ID] = entry
+		s.mu.Unlock()
+
+		last := record.LastFire
+		if last.IsZero() {
+			last = record.Created
+		}
+
+		s.start(entry, s.misfire(entry, last))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Stop stops all the named schedules without removing them from the store, e.g. when the process shuts down
+func (s *TimerScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, entry := range s.schedules {
+		entry.stop()
+		delete(s.schedules, id)
+	}
+}
+
+func (s *TimerScheduler) newNamedSchedule(record *ScheduleRecord) (*namedSchedule, error) {
+	location, err := time.LoadLocation(record.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := ParseCron(record.Cron, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var calendar Calendar
+	if record.Calendar != "" {
+		s.mu.Lock()
+		calendar = s.calendars[record.Calendar]
+		s.mu.Unlock()
+
+		if calendar == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCalendar, record.Calendar)
+		}
+	}
+
+	return &namedSchedule{
+		record:   *record,
+		schedule: schedule,
+		calendar: calendar,
+	}, nil
+}
+
+// misfire fires the schedule for the fire times missed since last according to its policy and returns the time
+// to compute the next fire time from
+func (s *TimerScheduler) misfire(entry *namedSchedule, last time.Time) time.Time {
+	now := time.Now()
+
+	missed := nextIncluded(entry.schedule, entry.calendar, last)
+	if missed.IsZero() || missed.After(now) {
+		return last
+	}
+
+	switch entry.record.Misfire {
+	case MisfireFireOnce:
+		s.fire(entry, missed)
+	case MisfireFireAll:
+		for !missed.IsZero() && !missed.After(now) {
+			s.fire(entry, missed)
+			missed = nextIncluded(entry.schedule, entry.calendar, missed)
+		}
+	}
+
+	return now
+}
+
+// start schedules the first fire time after the given time
+func (s *TimerScheduler) start(entry *namedSchedule, after time.Time) {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.stopped {
+		return
+	}
+
+	entry.next = nextIncluded(entry.schedule, entry.calendar, after)
+	if entry.next.IsZero() {
+		return
+	}
+
+	fireTime := entry.next
+	entry.timer = time.AfterFunc(time.Until(fireTime), func() {
+		s.fire(entry, fireTime)
+
+		now := time.Now()
+		if now.Before(fireTime) {
+			now = fireTime
+		}
+		s.start(entry, now)
+	})
+}
+
+func (s *TimerScheduler) fire(entry *namedSchedule, fireTime time.Time) {
+	// the lock is held while saving so that a cancelled schedule is never saved again
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.stopped {
+		return
+	}
+
+	entry.record.LastFire = fireTime
+
+	if entry.record.Request {
+		s.ctx.Request(entry.record.Target, entry.record.Message)
+	} else {
+		s.ctx.Send(entry.record.Target, entry.record.Message)
+	}
+
+	if s.store != nil {
+		record := entry.record
+		if err := s.store.SaveSchedule(&record); err != nil {
+			s.ctx.ActorSystem().Logger().Error("failed to save schedule", slog.String("id", record.ID), slog.Any("error", err))
+		}
+	}
+}
+
+func (entry *namedSchedule) stop() {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	entry.stopped = true
+	if entry.timer != nil {
+		entry.timer.Stop()
+	}
+}
@@ -0,0 +1,141 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCountingActor(n int) (*actor.PID, chan string) {
+	ch := make(chan string, n)
+	props := actor.PropsFromFunc(func(c actor.Context) {
+		if msg, ok := c.Message().(string); ok {
+			ch <- msg
+		}
+	})
+
+	return system.Root.Spawn(props), ch
+}
+
+func TestTimerScheduler_ScheduleCron(t *testing.T) {
+	s := NewTimerScheduler(system.Root)
+	pid, ch := newCountingActor(10)
+
+	require.NoError(t, s.ScheduleCron("every-second", "* * * * * *", pid, "tick"))
+	assert.ErrorIs(t, s.ScheduleCron("every-second", "* * * * * *", pid, "tick"), ErrScheduleExists)
+
+	schedules := s.Schedules()
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "every-second", schedules[0].ID)
+	assert.Equal(t, pid, schedules[0].Target)
+	assert.WithinDuration(t, time.Now(), schedules[0].NextFire, time.Second)
+
+	select {
+	case msg := <-ch:
+		assert.Equal(t, "tick", msg)
+	case <-time.After(2 * time.Second):
+		assert.Fail(t, "schedule did not fire")
+	}
+
+	cancelled, err := s.Cancel("every-second")
+	assert.NoError(t, err)
+	assert.True(t, cancelled)
+	assert.Empty(t, s.Schedules())
+
+	cancelled, err = s.Cancel("every-second")
+	assert.NoError(t, err)
+	assert.False(t, cancelled)
+}
+
+func TestTimerScheduler_UnknownCalendar(t *testing.T) {
+	s := NewTimerScheduler(system.Root)
+	pid, _ := newCountingActor(1)
+
+	err := s.ScheduleCron("holidays", "@daily", pid, "tick", WithCalendar("holidays"))
+	assert.ErrorIs(t, err, ErrUnknownCalendar)
+
+	s.AddCalendar("holidays", ExcludeWeekdays(time.Sunday))
+	assert.NoError(t, s.ScheduleCron("holidays", "@daily", pid, "tick", WithCalendar("holidays")))
+	s.Stop()
+}
+
+func TestTimerScheduler_Persistence(t *testing.T) {
+	store := NewInMemoryScheduleStore()
+	pid, _ := newCountingActor(1)
+
+	s := NewTimerScheduler(system.Root, WithStore(store))
+	require.NoError(t, s.ScheduleCron("daily", "@daily", pid, "tick", InLocation(time.UTC)))
+	require.NoError(t, s.ScheduleCron("hourly", "@hourly", pid, "tick"))
+	_, err := s.Cancel("hourly")
+	require.NoError(t, err)
+	s.Stop()
+
+	records, err := store.LoadSchedules()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "daily", records[0].ID)
+	assert.Equal(t, "UTC", records[0].Location)
+
+	// a restarted scheduler picks the schedules up again
+	restarted := NewTimerScheduler(system.Root, WithStore(store))
+	require.NoError(t, restarted.Restore())
+	defer restarted.Stop()
+
+	schedules := restarted.Schedules()
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "daily", schedules[0].ID)
+}
+
+func TestTimerScheduler_MisfirePolicies(t *testing.T) {
+	tests := []struct {
+		policy   MisfirePolicy
+		expected int
+	}{
+		{MisfireSkip, 0},
+		{MisfireFireOnce, 1},
+		{MisfireFireAll, 3},
+	}
+
+	for _, tt := range tests {
+		store := NewInMemoryScheduleStore()
+		pid, ch := newCountingActor(10)
+
+		// the process was down for the last three hours
+		lastFire := time.Now().Truncate(time.Hour).Add(-3 * time.Hour)
+		require.NoError(t, store.SaveSchedule(&ScheduleRecord{
+			ID:       "hourly",
+			Cron:     "@hourly",
+			Location: "UTC",
+			Target:   pid,
+			Message:  "tick",
+			Misfire:  tt.policy,
+			Created:  lastFire,
+			LastFire: lastFire,
+		}))
+
+		s := NewTimerScheduler(system.Root, WithStore(store))
+		require.NoError(t, s.Restore())
+
+		received := 0
+		for done := false; !done; {
+			select {
+			case <-ch:
+				received++
+			case <-time.After(50 * time.Millisecond):
+				done = true
+			}
+		}
+		s.Stop()
+
+		assert.Equal(t, tt.expected, received, "policy %d", tt.policy)
+
+		records, err := store.LoadSchedules()
+		require.NoError(t, err)
+		if tt.expected > 0 {
+			assert.True(t, records[0].LastFire.After(lastFire))
+		}
+	}
+}
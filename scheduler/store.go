@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// ScheduleRecord is the persisted state of a named cron schedule
+type ScheduleRecord struct {
+	ID       string
+	Cron     string
+	Location string
+	Calendar string
+	Target   *actor.PID
+	// Message is sent to the target, stores persisting to disk or a database are responsible for serializing it
+	Message  interface{}
+	Request  bool
+	Misfire  MisfirePolicy
+	Created  time.Time
+	LastFire time.Time
+}
+
+// ScheduleStore persists named schedules so that they survive a restart of the process
+type ScheduleStore interface {
+	SaveSchedule(record *ScheduleRecord) error
+	DeleteSchedule(id string) error
+	LoadSchedules() ([]*ScheduleRecord, error)
+}
+
+// InMemoryScheduleStore is a ScheduleStore keeping the schedules in memory, e.g. for tests
+type InMemoryScheduleStore struct {
+	mu      sync.RWMutex
+	records map[string]ScheduleRecord
+}
+
+var _ ScheduleStore = &InMemoryScheduleStore{}
+
+// NewInMemoryScheduleStore creates an empty InMemoryScheduleStore
+func NewInMemoryScheduleStore() *InMemoryScheduleStore {
+	return &InMemoryScheduleStore{records: make(map[string]ScheduleRecord)}
+}
+
+func (s *InMemoryScheduleStore) SaveSchedule(record *ScheduleRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[record.ID] = *record
+
+	return nil
+}
+
+func (s *InMemoryScheduleStore) DeleteSchedule(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, id)
+
+	return nil
+}
+
+func (s *InMemoryScheduleStore) LoadSchedules() ([]*ScheduleRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := make([]*ScheduleRecord, 0, len(s.records))
+	for _, record := range s.records {
+		record := record
+		records = append(records, &record)
+	}
+
+	return records, nil
+}
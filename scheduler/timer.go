@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"runtime"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -49,7 +50,11 @@ func startTimer(delay, interval time.Duration, fn func()) CancelFunc {
 
 // A scheduler utilizing timers to send messages in the future and at regular intervals.
 type TimerScheduler struct {
-	ctx actor.SenderContext
+	ctx       actor.SenderContext
+	store     ScheduleStore
+	mu        sync.Mutex
+	schedules map[string]*namedSchedule
+	calendars map[string]Calendar
 }
 
 type timerOptionFunc func(*TimerScheduler)
@@ -62,10 +67,21 @@ func WithContext(ctx actor.SenderContext) timerOptionFunc {
 	}
 }
 
+// WithStore configures the scheduler to save its named schedules to store, see TimerScheduler.Restore.
+func WithStore(store ScheduleStore) timerOptionFunc {
+	return func(s *TimerScheduler) {
+		s.store = store
+	}
+}
+
 // NewTimerScheduler creates a new scheduler using the EmptyRootContext.
 // Additional options may be specified to override the default behavior.
 func NewTimerScheduler(sender actor.SenderContext, opts ...timerOptionFunc) *TimerScheduler {
-	s := &TimerScheduler{ctx: sender}
+	s := &TimerScheduler{
+		ctx:       sender,
+		schedules: make(map[string]*namedSchedule),
+		calendars: make(map[string]Calendar),
+	}
 	for _, opt := range opts {
 		opt(s)
 	}
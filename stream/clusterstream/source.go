@@ -0,0 +1,28 @@
+// Package clusterstream provides stream stages backed by the cluster.
+package clusterstream
+
+import (
+	"log/slog"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/stream"
+)
+
+// FromTopic creates a source emitting the messages of type T published to the cluster pub-sub topic, the
+// subscription ends when the stream is cancelled
+func FromTopic[T any](c *cluster.Cluster, topic string, bufferSize int, overflow stream.OverflowPolicy) *stream.Source[T] {
+	return stream.FromSubscription[T](func(actorSystem *actor.ActorSystem, pid *actor.PID) (func(), error) {
+		if _, err := c.SubscribeByPid(topic, pid); err != nil {
+			return nil, err
+		}
+
+		return func() {
+			go func() {
+				if _, err := c.UnsubscribeByPid(topic, pid); err != nil {
+					actorSystem.Logger().Debug("failed to unsubscribe stream", slog.String("topic", topic), slog.Any("error", err))
+				}
+			}()
+		}, nil
+	}, bufferSize, overflow)
+}
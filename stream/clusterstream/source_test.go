@@ -0,0 +1,33 @@
+package clusterstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/cluster/cluster_test_tool"
+	"github.com/asynkron/protoactor-go/stream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestFromTopic(t *testing.T) {
+	fixture := cluster_test_tool.NewBaseInMemoryClusterFixture(1)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+
+	received := make(chan string, 3)
+	completion := FromTopic[*wrapperspb.StringValue](c, "topic", 10, stream.Fail).
+		RunWith(c.ActorSystem, stream.ForEach(func(value *wrapperspb.StringValue) { received <- value.Value }))
+	defer completion.Cancel()
+
+	for _, value := range []string{"first", "second", "third"} {
+		_, err := c.Publisher().Publish(context.Background(), "topic", wrapperspb.String(value), cluster.WithTimeout(time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, value, <-received)
+	}
+}
@@ -0,0 +1,387 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// stage is the part of a flow both consuming the upstream stage and emitting to the downstream stage
+type stage struct {
+	inlet
+	outlet
+}
+
+// receive handles the protocol messages all flows treat alike, it returns false for the other messages
+func (s *stage) receive(ctx actor.Context) bool {
+	switch msg := ctx.Message().(type) {
+	case *subscribed:
+		s.subscribe(ctx, msg.upstream)
+	case *cancel:
+		s.inlet.cancel(ctx)
+		ctx.Stop(ctx.Self())
+	case *failure:
+		s.fail(ctx, msg.err)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// abort cancels the upstream stage and fails the downstream stage, e.g. when a user function failed
+func (s *stage) abort(ctx actor.Context, err error) {
+	s.inlet.cancel(ctx)
+	s.fail(ctx, err)
+}
+
+func newFlow[In, Out any](producer func(downstream *actor.PID) actor.Actor) *Flow[In, Out] {
+	return &Flow[In, Out]{
+		materialize: func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID {
+			return actorSystem.Root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+				return producer(downstream)
+			}))
+		},
+	}
+}
+
+// Map creates a flow emitting f(element) for every element
+func Map[In, Out any](f func(In) Out) *Flow[In, Out] {
+	return newFlow[In, Out](func(downstream *actor.PID) actor.Actor {
+		return &mapStage[In, Out]{stage: stage{outlet: outlet{downstream: downstream}}, f: f}
+	})
+}
+
+type mapStage[In, Out any] struct {
+	stage
+	f func(In) Out
+}
+
+func (s *mapStage[In, Out]) Receive(ctx actor.Context) {
+	if s.receive(ctx) {
+		return
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *request:
+		s.demand += msg.n
+		s.request(ctx, msg.n)
+	case *element:
+		var out Out
+		if err := safely(func() { out = s.f(msg.value.(In)) }); err != nil {
+			s.abort(ctx, err)
+			return
+		}
+		s.push(ctx, out)
+	case *complete:
+		s.complete(ctx)
+	}
+}
+
+// Filter creates a flow emitting the elements the predicate returns true for
+func Filter[T any](predicate func(T) bool) *Flow[T, T] {
+	return newFlow[T, T](func(downstream *actor.PID) actor.Actor {
+		return &filterStage[T]{stage: stage{outlet: outlet{downstream: downstream}}, predicate: predicate}
+	})
+}
+
+type filterStage[T any] struct {
+	stage
+	predicate func(T) bool
+}
+
+func (s *filterStage[T]) Receive(ctx actor.Context) {
+	if s.receive(ctx) {
+		return
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *request:
+		s.demand += msg.n
+		s.request(ctx, msg.n)
+	case *element:
+		var ok bool
+		if err := safely(func() { ok = s.predicate(msg.value.(T)) }); err != nil {
+			s.abort(ctx, err)
+			return
+		}
+
+		if ok {
+			s.push(ctx, msg.value)
+		} else {
+			// the element did not satisfy the demand, request another one in its place
+			s.request(ctx, 1)
+		}
+	case *complete:
+		s.complete(ctx)
+	}
+}
+
+// Batch creates a flow grouping the elements in slices of up to size elements. A slice is emitted once it is
+// full or maxWait after its first element, whichever comes first.
+func Batch[T any](size int, maxWait time.Duration) *Flow[T, []T] {
+	return newFlow[T, []T](func(downstream *actor.PID) actor.Actor {
+		return &batchStage[T]{stage: stage{outlet: outlet{downstream: downstream}}, size: size, maxWait: maxWait}
+	})
+}
+
+// batchDue is sent to a batch stage once maxWait elapsed after the first element of a batch
+type batchDue struct {
+	generation int
+}
+
+type batchStage[T any] struct {
+	stage
+	size       int
+	maxWait    time.Duration
+	batch      []T
+	requested  int
+	generation int
+	timer      *time.Timer
+	due        bool
+	completing bool
+}
+
+func (s *batchStage[T]) Receive(ctx actor.Context) {
+	if s.receive(ctx) {
+		return
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *request:
+		s.demand += msg.n
+		if s.due || len(s.batch) >= s.size || s.completing {
+			s.emit(ctx)
+		}
+		s.pull(ctx)
+	case *element:
+		s.requested--
+		s.batch = append(s.batch, msg.value.(T))
+		if len(s.batch) == 1 {
+			s.startTimer(ctx)
+		}
+		if len(s.batch) >= s.size {
+			s.emit(ctx)
+		}
+		s.pull(ctx)
+	case *batchDue:
+		if msg.generation == s.generation {
+			s.due = true
+			s.emit(ctx)
+			s.pull(ctx)
+		}
+	case *complete:
+		s.completing = true
+		s.emit(ctx)
+	case *actor.Stopped:
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+	}
+}
+
+// pull requests the elements missing from the current batch once the downstream stage requested it
+func (s *batchStage[T]) pull(ctx actor.Context) {
+	if s.demand == 0 || s.requested > 0 || s.completing || len(s.batch) >= s.size {
+		return
+	}
+
+	n := s.size - len(s.batch)
+	s.requested += n
+	s.request(ctx, n)
+}
+
+func (s *batchStage[T]) emit(ctx actor.Context) {
+	if s.demand > 0 && len(s.batch) > 0 {
+		s.push(ctx, s.batch)
+		s.batch = nil
+		s.due = false
+		s.generation++
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+	}
+
+	if s.completing && len(s.batch) == 0 {
+		s.complete(ctx)
+	}
+}
+
+func (s *batchStage[T]) startTimer(ctx actor.Context) {
+	actorSystem, self, generation := ctx.ActorSystem(), ctx.Self(), s.generation
+	s.timer = time.AfterFunc(s.maxWait, func() {
+		actorSystem.Root.Send(self, &batchDue{generation: generation})
+	})
+}
+
+// Throttle creates a flow emitting at most elements elements per period, up to elements elements can be emitted
+// in a burst
+func Throttle[T any](elements int, per time.Duration) *Flow[T, T] {
+	return newFlow[T, T](func(downstream *actor.PID) actor.Actor {
+		return &throttleStage{stage: stage{outlet: outlet{downstream: downstream}}, elements: elements, per: per, tokens: elements}
+	})
+}
+
+type refill struct{}
+
+var refillMessage = &refill{}
+
+type throttleStage struct {
+	stage
+	elements  int
+	per       time.Duration
+	tokens    int
+	requested int
+	timer     *time.Timer
+}
+
+func (s *throttleStage) Receive(ctx actor.Context) {
+	if s.receive(ctx) {
+		return
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		s.schedule(ctx)
+	case *request:
+		s.demand += msg.n
+		s.pull(ctx)
+	case *element:
+		s.requested--
+		s.push(ctx, msg.value)
+	case *refill:
+		s.tokens = s.elements
+		s.pull(ctx)
+		s.schedule(ctx)
+	case *complete:
+		s.complete(ctx)
+	case *actor.Stopped:
+		s.timer.Stop()
+	}
+}
+
+// pull requests as many elements as the tokens allow, the elements requested but not yet received count
+// against the demand
+func (s *throttleStage) pull(ctx actor.Context) {
+	n := min(s.demand-s.requested, s.tokens)
+	if n <= 0 {
+		return
+	}
+
+	s.tokens -= n
+	s.requested += n
+	s.request(ctx, n)
+}
+
+func (s *throttleStage) schedule(ctx actor.Context) {
+	actorSystem, self := ctx.ActorSystem(), ctx.Self()
+	s.timer = time.AfterFunc(s.per, func() {
+		actorSystem.Root.Send(self, refillMessage)
+	})
+}
+
+// MapAsync creates a flow calling f for up to parallelism elements concurrently, the results are emitted in the
+// order of the elements. The stream fails with the first error f returns.
+func MapAsync[In, Out any](parallelism int, f func(In) (Out, error)) *Flow[In, Out] {
+	return newFlow[In, Out](func(downstream *actor.PID) actor.Actor {
+		return &mapAsyncStage[In, Out]{
+			stage:       stage{outlet: outlet{downstream: downstream}},
+			parallelism: parallelism,
+			f:           f,
+			results:     make(map[int]Out),
+		}
+	})
+}
+
+type asyncResult[Out any] struct {
+	sequence int
+	value    Out
+	err      error
+}
+
+type mapAsyncStage[In, Out any] struct {
+	stage
+	parallelism int
+	f           func(In) (Out, error)
+	requested   int
+	received    int
+	emitted     int
+	results     map[int]Out
+	completing  bool
+}
+
+func (s *mapAsyncStage[In, Out]) Receive(ctx actor.Context) {
+	if s.receive(ctx) {
+		return
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		s.pull(ctx)
+	case *request:
+		s.demand += msg.n
+		s.emit(ctx)
+	case *element:
+		s.requested--
+		s.run(ctx, s.received, msg.value.(In))
+		s.received++
+	case *asyncResult[Out]:
+		if msg.err != nil {
+			s.abort(ctx, msg.err)
+			return
+		}
+		s.results[msg.sequence] = msg.value
+		s.emit(ctx)
+	case *complete:
+		s.completing = true
+		s.requested = 0
+		s.emit(ctx)
+	}
+}
+
+func (s *mapAsyncStage[In, Out]) run(ctx actor.Context, sequence int, value In) {
+	actorSystem, self := ctx.ActorSystem(), ctx.Self()
+
+	go func() {
+		result := &asyncResult[Out]{sequence: sequence}
+		if err := safely(func() { result.value, result.err = s.f(value) }); err != nil {
+			result.err = err
+		}
+
+		actorSystem.Root.Send(self, result)
+	}()
+}
+
+// emit pushes the results that are next in order as long as there is demand
+func (s *mapAsyncStage[In, Out]) emit(ctx actor.Context) {
+	for s.demand > 0 {
+		value, ok := s.results[s.emitted]
+		if !ok {
+			break
+		}
+
+		delete(s.results, s.emitted)
+		s.emitted++
+		s.push(ctx, value)
+	}
+
+	if s.completing {
+		if s.emitted == s.received {
+			s.complete(ctx)
+		}
+		return
+	}
+
+	s.pull(ctx)
+}
+
+// pull keeps up to parallelism elements requested, running or waiting to be emitted
+func (s *mapAsyncStage[In, Out]) pull(ctx actor.Context) {
+	n := s.parallelism - s.requested - (s.received - s.emitted)
+	if n <= 0 {
+		return
+	}
+
+	s.requested += n
+	s.request(ctx, n)
+}
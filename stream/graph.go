@@ -0,0 +1,187 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// ErrCancelled is the error of a Completion whose stream was cancelled before it completed
+var ErrCancelled = errors.New("stream: cancelled")
+
+// Source is the blueprint of a stage emitting elements of type T. Every stage runs as an actor once the
+// graph is run and only emits as many elements as the downstream stage requested.
+type Source[T any] struct {
+	materialize func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID
+}
+
+// Flow is the blueprint of a stage transforming elements of type In to elements of type Out
+type Flow[In, Out any] struct {
+	materialize func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID
+}
+
+// Sink is the blueprint of a stage consuming elements of type T
+type Sink[T any] struct {
+	materialize func(actorSystem *actor.ActorSystem) (*actor.PID, *Completion)
+}
+
+// Via connects the source to the flow, the result is a source emitting the elements of the flow
+func Via[In, Out any](source *Source[In], flow *Flow[In, Out]) *Source[Out] {
+	return &Source[Out]{
+		materialize: func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID {
+			pid := flow.materialize(actorSystem, downstream)
+			connect(actorSystem, source.materialize(actorSystem, pid), pid)
+
+			return pid
+		},
+	}
+}
+
+// RunWith spawns the actors of all the stages of the source and the sink, the returned Completion completes once
+// the sink consumed the last element
+func (s *Source[T]) RunWith(actorSystem *actor.ActorSystem, sink *Sink[T]) *Completion {
+	pid, completion := sink.materialize(actorSystem)
+	connect(actorSystem, s.materialize(actorSystem, pid), pid)
+
+	return completion
+}
+
+func connect(actorSystem *actor.ActorSystem, upstream *actor.PID, downstream *actor.PID) {
+	actorSystem.Root.Send(downstream, &subscribed{upstream: upstream})
+}
+
+// Completion tracks a running stream
+type Completion struct {
+	actorSystem *actor.ActorSystem
+	sink        *actor.PID
+	done        chan struct{}
+	once        sync.Once
+	err         error
+}
+
+func newCompletion(actorSystem *actor.ActorSystem) *Completion {
+	return &Completion{actorSystem: actorSystem, done: make(chan struct{})}
+}
+
+// Done is closed once the stream completed, failed or was cancelled
+func (c *Completion) Done() <-chan struct{} {
+	return c.done
+}
+
+// Wait blocks until the stream completed and returns the error it failed with, if any
+func (c *Completion) Wait() error {
+	<-c.done
+
+	return c.err
+}
+
+// Cancel stops all the stages of the stream, Wait returns ErrCancelled unless the stream completed before
+func (c *Completion) Cancel() {
+	c.actorSystem.Root.Send(c.sink, cancelMessage)
+}
+
+func (c *Completion) complete(err error) {
+	c.once.Do(func() {
+		c.err = err
+		close(c.done)
+	})
+}
+
+// the demand protocol between the stage actors
+
+// subscribed tells a stage which stage it consumes
+type subscribed struct {
+	upstream *actor.PID
+}
+
+// request signals the upstream stage that n more elements can be emitted
+type request struct {
+	n int
+}
+
+// cancel tells the upstream stage that no more elements are requested
+type cancel struct{}
+
+var cancelMessage = &cancel{}
+
+type element struct {
+	value interface{}
+}
+
+type complete struct{}
+
+var completeMessage = &complete{}
+
+type failure struct {
+	err error
+}
+
+// outlet keeps track of the demand of the downstream stage
+type outlet struct {
+	downstream *actor.PID
+	demand     int
+}
+
+func (o *outlet) push(ctx actor.Context, value interface{}) {
+	o.demand--
+	ctx.Send(o.downstream, &element{value: value})
+}
+
+func (o *outlet) complete(ctx actor.Context) {
+	ctx.Send(o.downstream, completeMessage)
+	ctx.Stop(ctx.Self())
+}
+
+func (o *outlet) fail(ctx actor.Context, err error) {
+	ctx.Send(o.downstream, &failure{err: err})
+	ctx.Stop(ctx.Self())
+}
+
+// inlet requests elements from the upstream stage, demand requested before the upstream is known is kept
+// until it subscribed
+type inlet struct {
+	upstream *actor.PID
+	pending  int
+}
+
+func (i *inlet) subscribe(ctx actor.Context, upstream *actor.PID) {
+	i.upstream = upstream
+	if i.pending > 0 {
+		ctx.Send(upstream, &request{n: i.pending})
+		i.pending = 0
+	}
+}
+
+func (i *inlet) request(ctx actor.Context, n int) {
+	if n <= 0 {
+		return
+	}
+
+	if i.upstream == nil {
+		i.pending += n
+		return
+	}
+
+	ctx.Send(i.upstream, &request{n: n})
+}
+
+func (i *inlet) cancel(ctx actor.Context) {
+	if i.upstream != nil {
+		ctx.Send(i.upstream, cancelMessage)
+	}
+}
+
+// safely calls f, a panic is turned into an error failing the stream
+func safely(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("stream: stage panicked: %v", r)
+		}
+	}()
+
+	f()
+
+	return nil
+}
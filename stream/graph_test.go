@@ -0,0 +1,263 @@
+package stream
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collect[T any](t *testing.T, system *actor.ActorSystem, source *Source[T]) []T {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		result []T
+	)
+
+	completion := source.RunWith(system, ForEach(func(value T) {
+		mu.Lock()
+		defer mu.Unlock()
+		result = append(result, value)
+	}))
+	require.NoError(t, completion.Wait())
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	return result
+}
+
+func TestMapFilter(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	source := Via(FromSlice(1, 2, 3, 4, 5, 6), Filter(func(i int) bool { return i%2 == 0 }))
+	result := collect(t, system, Via(source, Map(func(i int) int { return i * 10 })))
+
+	assert.Equal(t, []int{20, 40, 60}, result)
+}
+
+func TestMap_PanicFailsStream(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	source := Via(FromSlice(1, 2, 3), Map(func(i int) int {
+		if i == 2 {
+			panic("boom")
+		}
+		return i
+	}))
+
+	err := source.RunWith(system, ForEach(func(int) {})).Wait()
+	assert.ErrorContains(t, err, "boom")
+}
+
+func TestBatch(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	result := collect(t, system, Via(FromSlice(1, 2, 3, 4, 5), Batch[int](2, time.Second)))
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, result)
+}
+
+func TestBatch_EmitsAfterMaxWait(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	source := NewPIDSource[int](system, 10, Fail)
+	c := make(chan []int, 1)
+	Via(source.Source(), Batch[int](10, 50*time.Millisecond)).RunWith(system, ToChannel(c))
+
+	system.Root.Send(source.PID(), 1)
+	system.Root.Send(source.PID(), 2)
+
+	select {
+	case batch := <-c:
+		assert.Equal(t, []int{1, 2}, batch)
+	case <-time.After(time.Second):
+		t.Fatal("batch not emitted after max wait")
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	start := time.Now()
+	result := collect(t, system, Via(FromSlice(1, 2, 3, 4, 5, 6), Throttle[int](2, 50*time.Millisecond)))
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, result)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestMapAsync_KeepsOrderAndBoundsParallelism(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	var running, maxRunning int32
+	source := Via(FromSlice(5, 4, 3, 2, 1), MapAsync(2, func(i int) (int, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			observed := atomic.LoadInt32(&maxRunning)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+				break
+			}
+		}
+		time.Sleep(time.Duration(i) * 5 * time.Millisecond)
+		return i * 2, nil
+	}))
+
+	result := collect(t, system, source)
+
+	assert.Equal(t, []int{10, 8, 6, 4, 2}, result)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}
+
+func TestMapAsync_ErrorFailsStream(t *testing.T) {
+	system := actor.NewActorSystem()
+	failed := errors.New("failed")
+
+	source := Via(FromSlice(1, 2, 3), MapAsync(2, func(i int) (int, error) {
+		if i == 2 {
+			return 0, failed
+		}
+		return i, nil
+	}))
+
+	err := source.RunWith(system, ForEach(func(int) {})).Wait()
+	assert.ErrorIs(t, err, failed)
+}
+
+func TestFromChannel_ReadsOnDemand(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	in := make(chan int)
+	out := make(chan int)
+	completion := FromChannel(in).RunWith(system, ToChannel(out))
+
+	go func() {
+		for i := 0; i < 100; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+
+	// the reader is slow, the stream only reads what the sink buffer can hold
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, i, <-out)
+	}
+
+	_, ok := <-out
+	assert.False(t, ok)
+	assert.NoError(t, completion.Wait())
+}
+
+func TestToChannel_SlowReaderDoesNotBlockSink(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	source := NewPIDSource[int](system, 1000, Fail)
+	out := make(chan int)
+	completion := source.Source().RunWith(system, ToChannel(out))
+
+	for i := 0; i < 100; i++ {
+		system.Root.Send(source.PID(), i)
+	}
+
+	// nobody reads the channel yet, the sink actor still processes cancel
+	completion.Cancel()
+
+	select {
+	case <-completion.Done():
+	case <-time.After(time.Second):
+		t.Fatal("stream not cancelled")
+	}
+	assert.ErrorIs(t, completion.Wait(), ErrCancelled)
+}
+
+func TestPIDSource_Overflow(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	source := NewPIDSource[int](system, 2, DropOldest)
+	for i := 0; i < 5; i++ {
+		system.Root.Send(source.PID(), i)
+	}
+	source.Complete(system)
+
+	assert.Equal(t, []int{3, 4}, collect(t, system, source.Source()))
+}
+
+func TestPIDSource_OverflowFails(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	source := NewPIDSource[int](system, 1, Fail)
+	completion := Via(source.Source(), Throttle[int](1, time.Hour)).RunWith(system, ForEach(func(int) {}))
+
+	for i := 0; i < 10; i++ {
+		system.Root.Send(source.PID(), i)
+	}
+
+	assert.ErrorIs(t, completion.Wait(), ErrBufferOverflow)
+}
+
+func TestFromSubscription_UnsubscribesWhenCancelled(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	unsubscribed := make(chan struct{})
+	source := FromSubscription[int](func(actorSystem *actor.ActorSystem, pid *actor.PID) (func(), error) {
+		for i := 0; i < 3; i++ {
+			actorSystem.Root.Send(pid, i)
+		}
+
+		return func() { close(unsubscribed) }, nil
+	}, 10, Fail)
+
+	received := make(chan int, 3)
+	completion := source.RunWith(system, ForEach(func(value int) { received <- value }))
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, i, <-received)
+	}
+
+	completion.Cancel()
+	assert.ErrorIs(t, completion.Wait(), ErrCancelled)
+
+	select {
+	case <-unsubscribed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "not unsubscribed")
+	}
+}
+
+func TestFromSubscription_SubscribeErrorFailsStream(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	failure := errors.New("boom")
+	source := FromSubscription[int](func(*actor.ActorSystem, *actor.PID) (func(), error) {
+		return nil, failure
+	}, 10, Fail)
+
+	assert.ErrorIs(t, source.RunWith(system, ForEach(func(int) {})).Wait(), failure)
+}
+
+func TestPIDSource_Any(t *testing.T) {
+	system := actor.NewActorSystem()
+
+	source := NewPIDSource[any](system, 10, Fail)
+	system.Root.Send(source.PID(), 1)
+	system.Root.Send(source.PID(), "two")
+	source.Complete(system)
+
+	var received []any
+	completion := source.Source().RunWith(system, ForEach(func(value any) {
+		received = append(received, value)
+	}))
+
+	select {
+	case <-completion.Done():
+		require.NoError(t, completion.Wait())
+		assert.Equal(t, []any{1, "two"}, received)
+	case <-time.After(time.Second):
+		assert.Fail(t, "stream did not complete")
+	}
+}
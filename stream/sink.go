@@ -0,0 +1,171 @@
+package stream
+
+import (
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// sinkBatchSize is the number of elements a sink keeps requested from the upstream stage
+const sinkBatchSize = 16
+
+// sink is the part of a sink consuming the upstream stage and completing the Completion of the stream
+type sink struct {
+	inlet
+	completion *Completion
+	consumed   int
+}
+
+func newSink[T any](producer func(completion *Completion) actor.Actor) *Sink[T] {
+	return &Sink[T]{
+		materialize: func(actorSystem *actor.ActorSystem) (*actor.PID, *Completion) {
+			completion := newCompletion(actorSystem)
+			completion.sink = actorSystem.Root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+				return producer(completion)
+			}))
+
+			return completion.sink, completion
+		},
+	}
+}
+
+// receive handles the protocol messages all sinks treat alike, it returns false for the other messages
+func (s *sink) receive(ctx actor.Context) bool {
+	switch msg := ctx.Message().(type) {
+	case *subscribed:
+		s.subscribe(ctx, msg.upstream)
+	case *cancel:
+		s.inlet.cancel(ctx)
+		s.done(ctx, ErrCancelled)
+	case *failure:
+		s.done(ctx, msg.err)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// consume requests more elements once half of the requested ones were consumed
+func (s *sink) consume(ctx actor.Context) {
+	s.consumed++
+	if s.consumed == sinkBatchSize/2 {
+		s.request(ctx, s.consumed)
+		s.consumed = 0
+	}
+}
+
+func (s *sink) done(ctx actor.Context, err error) {
+	s.completion.complete(err)
+	ctx.Stop(ctx.Self())
+}
+
+// ForEach creates a sink calling f for every element. The stream fails if f panics.
+func ForEach[T any](f func(T)) *Sink[T] {
+	return newSink[T](func(completion *Completion) actor.Actor {
+		return &forEachSink[T]{sink: sink{completion: completion}, f: f}
+	})
+}
+
+type forEachSink[T any] struct {
+	sink
+	f func(T)
+}
+
+func (s *forEachSink[T]) Receive(ctx actor.Context) {
+	if s.receive(ctx) {
+		return
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		s.request(ctx, sinkBatchSize)
+	case *element:
+		if err := safely(func() { s.f(msg.value.(T)) }); err != nil {
+			s.inlet.cancel(ctx)
+			s.done(ctx, err)
+			return
+		}
+		s.consume(ctx)
+	case *complete:
+		s.done(ctx, nil)
+	}
+}
+
+// ToChannel creates a sink writing the elements to the channel and closing it once the stream completed.
+// The elements are written from a dedicated goroutine, a slow reader slows down the stream rather than
+// blocking the dispatcher.
+func ToChannel[T any](c chan<- T) *Sink[T] {
+	return newSink[T](func(completion *Completion) actor.Actor {
+		return &channelSink[T]{
+			sink:      sink{completion: completion},
+			c:         c,
+			elements:  make(chan T, sinkBatchSize),
+			cancelled: make(chan struct{}),
+		}
+	})
+}
+
+// written is sent to a channel sink once the goroutine wrote an element to the channel
+type written struct{}
+
+var writtenMessage = &written{}
+
+type channelSink[T any] struct {
+	sink
+	c         chan<- T
+	elements  chan T
+	cancelled chan struct{}
+	// err is set before elements is closed and read by the goroutine once it is
+	err error
+}
+
+func (s *channelSink[T]) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		go s.write(ctx.ActorSystem(), ctx.Self())
+		s.request(ctx, sinkBatchSize)
+	case *subscribed:
+		s.subscribe(ctx, msg.upstream)
+	case *element:
+		// never blocks, no more elements than the capacity of the buffer are requested
+		s.elements <- msg.value.(T)
+	case *written:
+		s.consume(ctx)
+	case *complete:
+		close(s.elements)
+		ctx.Stop(ctx.Self())
+	case *failure:
+		s.err = msg.err
+		close(s.elements)
+		ctx.Stop(ctx.Self())
+	case *cancel:
+		s.inlet.cancel(ctx)
+		close(s.cancelled)
+		ctx.Stop(ctx.Self())
+	}
+}
+
+// write writes the buffered elements to the channel until the stream completed or was cancelled
+func (s *channelSink[T]) write(actorSystem *actor.ActorSystem, self *actor.PID) {
+	defer close(s.c)
+
+	for {
+		select {
+		case <-s.cancelled:
+			s.completion.complete(ErrCancelled)
+			return
+		case value, ok := <-s.elements:
+			if !ok {
+				s.completion.complete(s.err)
+				return
+			}
+
+			select {
+			case s.c <- value:
+				actorSystem.Root.Send(self, writtenMessage)
+			case <-s.cancelled:
+				s.completion.complete(ErrCancelled)
+				return
+			}
+		}
+	}
+}
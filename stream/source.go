@@ -0,0 +1,312 @@
+package stream
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// ErrBufferOverflow fails a stream whose source buffer overflowed with the Fail policy
+var ErrBufferOverflow = errors.New("stream: source buffer overflow")
+
+// OverflowPolicy decides what a buffering source does with a message when its buffer is full
+type OverflowPolicy int32
+
+const (
+	// DropNewest discards the message that does not fit in the buffer
+	DropNewest OverflowPolicy = iota
+	// DropOldest discards the oldest buffered message to make room
+	DropOldest
+	// Fail fails the stream with ErrBufferOverflow
+	Fail
+)
+
+// FromSlice creates a source emitting the values and completing after the last one
+func FromSlice[T any](values ...T) *Source[T] {
+	return &Source[T]{
+		materialize: func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID {
+			return actorSystem.Root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+				return &sliceSource[T]{outlet: outlet{downstream: downstream}, values: values}
+			}))
+		},
+	}
+}
+
+type sliceSource[T any] struct {
+	outlet
+	values []T
+}
+
+func (s *sliceSource[T]) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *request:
+		s.demand += msg.n
+		for s.demand > 0 && len(s.values) > 0 {
+			s.push(ctx, s.values[0])
+			s.values = s.values[1:]
+		}
+
+		if len(s.values) == 0 {
+			s.complete(ctx)
+		}
+	case *cancel:
+		ctx.Stop(ctx.Self())
+	}
+}
+
+// FromChannel creates a source emitting the values received from the channel and completing when it is closed.
+// The channel is only read when the downstream stage requested elements.
+func FromChannel[T any](c <-chan T) *Source[T] {
+	return &Source[T]{
+		materialize: func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID {
+			return actorSystem.Root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+				s := &channelSource[T]{outlet: outlet{downstream: downstream}, c: c, stopped: make(chan struct{})}
+				s.cond = sync.NewCond(&s.mu)
+
+				return s
+			}))
+		},
+	}
+}
+
+type channelSource[T any] struct {
+	outlet
+	c       <-chan T
+	mu      sync.Mutex
+	cond    *sync.Cond
+	credit  int
+	stopped chan struct{}
+}
+
+func (s *channelSource[T]) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		go s.pump(ctx.ActorSystem(), ctx.Self())
+	case *request:
+		s.mu.Lock()
+		s.credit += msg.n
+		s.cond.Signal()
+		s.mu.Unlock()
+	case *element:
+		s.push(ctx, msg.value)
+	case *complete:
+		s.complete(ctx)
+	case *cancel:
+		ctx.Stop(ctx.Self())
+	case *actor.Stopped:
+		s.mu.Lock()
+		close(s.stopped)
+		s.cond.Signal()
+		s.mu.Unlock()
+	}
+}
+
+// pump reads the channel as long as there is demand, the values are handed to the actor to be emitted
+func (s *channelSource[T]) pump(actorSystem *actor.ActorSystem, self *actor.PID) {
+	for {
+		s.mu.Lock()
+		for s.credit == 0 && !s.isStopped() {
+			s.cond.Wait()
+		}
+		s.credit--
+		s.mu.Unlock()
+
+		select {
+		case <-s.stopped:
+			return
+		case value, ok := <-s.c:
+			if !ok {
+				actorSystem.Root.Send(self, completeMessage)
+				return
+			}
+			actorSystem.Root.Send(self, &element{value: value})
+		}
+	}
+}
+
+func (s *channelSource[T]) isStopped() bool {
+	select {
+	case <-s.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// PIDSource is a source emitting the messages of type T sent to its PID. Messages arriving faster than they are
+// requested are buffered, the overflow policy applies once the buffer is full.
+// A PIDSource can be run once.
+type PIDSource[T any] struct {
+	pid *actor.PID
+}
+
+// NewPIDSource spawns the actor of a PIDSource
+func NewPIDSource[T any](actorSystem *actor.ActorSystem, bufferSize int, overflow OverflowPolicy) *PIDSource[T] {
+	pid := actorSystem.Root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return &bufferSource[T]{size: bufferSize, overflow: overflow}
+	}))
+
+	return &PIDSource[T]{pid: pid}
+}
+
+// PID returns the PID the elements of the source are sent to
+func (s *PIDSource[T]) PID() *actor.PID {
+	return s.pid
+}
+
+// Complete completes the stream once the buffered elements have been emitted
+func (s *PIDSource[T]) Complete(actorSystem *actor.ActorSystem) {
+	actorSystem.Root.Send(s.pid, completeMessage)
+}
+
+// Source returns the source emitting the elements sent to the PID
+func (s *PIDSource[T]) Source() *Source[T] {
+	return &Source[T]{
+		materialize: func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID {
+			actorSystem.Root.Send(s.pid, &attach{downstream: downstream})
+
+			return s.pid
+		},
+	}
+}
+
+// FromSubscription creates a source emitting the messages of type T sent to the PID of the source, buffered like
+// a PIDSource. subscribe registers the PID with a publisher once the stream runs, the returned unsubscribe is
+// called when the source stops and must not block. A subscribe error fails the stream.
+func FromSubscription[T any](subscribe func(actorSystem *actor.ActorSystem, pid *actor.PID) (unsubscribe func(), err error), bufferSize int, overflow OverflowPolicy) *Source[T] {
+	return &Source[T]{
+		materialize: func(actorSystem *actor.ActorSystem, downstream *actor.PID) *actor.PID {
+			sub := &subscription{}
+			pid := actorSystem.Root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+				return &bufferSource[T]{
+					outlet:   outlet{downstream: downstream},
+					size:     bufferSize,
+					overflow: overflow,
+					onStopped: func(_ *actor.PID) {
+						sub.stop()
+					},
+				}
+			}))
+
+			unsubscribe, err := subscribe(actorSystem, pid)
+			if err != nil {
+				actorSystem.Root.Send(pid, &failure{err: err})
+				return pid
+			}
+			sub.set(unsubscribe)
+
+			return pid
+		},
+	}
+}
+
+// subscription calls unsubscribe once both the subscription completed and the source stopped
+type subscription struct {
+	mu          sync.Mutex
+	unsubscribe func()
+	stopped     bool
+}
+
+func (s *subscription) set(unsubscribe func()) {
+	s.mu.Lock()
+	stopped := s.stopped
+	s.unsubscribe = unsubscribe
+	s.mu.Unlock()
+
+	if stopped && unsubscribe != nil {
+		unsubscribe()
+	}
+}
+
+func (s *subscription) stop() {
+	s.mu.Lock()
+	s.stopped = true
+	unsubscribe := s.unsubscribe
+	s.mu.Unlock()
+
+	if unsubscribe != nil {
+		unsubscribe()
+	}
+}
+
+// attach connects a PIDSource to the downstream stage of the stream it is run in
+type attach struct {
+	downstream *actor.PID
+}
+
+type bufferSource[T any] struct {
+	outlet
+	buffer     []T
+	size       int
+	overflow   OverflowPolicy
+	completing bool
+	onStopped  func(self *actor.PID)
+}
+
+func (s *bufferSource[T]) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *attach:
+		s.downstream = msg.downstream
+	case *request:
+		s.demand += msg.n
+		s.drain(ctx)
+	case *complete:
+		s.completing = true
+		s.drain(ctx)
+	case *failure:
+		s.fail(ctx, msg.err)
+	case *cancel:
+		ctx.Stop(ctx.Self())
+	case *actor.Stopped:
+		if s.onStopped != nil {
+			s.onStopped(ctx.Self())
+		}
+	case actor.AutoReceiveMessage, actor.SystemMessage:
+		// lifecycle messages are not elements, even when T is an interface they implement
+	case T:
+		s.enqueue(ctx, msg)
+	}
+}
+
+func (s *bufferSource[T]) enqueue(ctx actor.Context, value T) {
+	if s.completing {
+		return
+	}
+
+	if s.downstream != nil && s.demand > 0 {
+		s.push(ctx, value)
+		return
+	}
+
+	if len(s.buffer) >= s.size {
+		switch s.overflow {
+		case DropNewest:
+			return
+		case DropOldest:
+			s.buffer = s.buffer[1:]
+		case Fail:
+			if s.downstream != nil {
+				s.fail(ctx, ErrBufferOverflow)
+			}
+			return
+		}
+	}
+
+	s.buffer = append(s.buffer, value)
+}
+
+func (s *bufferSource[T]) drain(ctx actor.Context) {
+	if s.downstream == nil {
+		return
+	}
+
+	for s.demand > 0 && len(s.buffer) > 0 {
+		s.push(ctx, s.buffer[0])
+		s.buffer = s.buffer[1:]
+	}
+
+	if s.completing && len(s.buffer) == 0 {
+		s.complete(ctx)
+	}
+}